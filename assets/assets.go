@@ -25,12 +25,20 @@ var FontMono []byte
 //go:embed fonts/DejaVuBold.ttf
 var FontDejaVuBold []byte
 
+// Noto Sans CJK SC (SIL Open Font License 1.1) converted to TrueType outlines and
+// subset to the GB2312 characters, the CJK punctuation and the fullwidth forms, see
+// fonts/OFL.txt
+//
+//go:embed fonts/NotoSansSC.ttf
+var FontNotoSansSC []byte
+
 var AllFonts = map[string][]byte{
 	"Arial":      FontAreal,
 	"Times":      FontTimes,
 	"TimesBold":  FontTimesBold,
 	"Mono":       FontMono,
 	"DejaVuBold": FontDejaVuBold,
+	"NotoSansSC": FontNotoSansSC,
 }
 
 // images
@@ -0,0 +1,206 @@
+package batch
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AlexNa-Holdings/savva-reports/cmn"
+	"github.com/AlexNa-Holdings/savva-reports/data"
+	"github.com/AlexNa-Holdings/savva-reports/period"
+	"github.com/AlexNa-Holdings/savva-reports/reports"
+	"github.com/rs/zerolog/log"
+)
+
+type Options struct {
+	Period    period.Period
+	Locales   []string
+	Currency  string   // ISO code, empty for the default currency of the config
+	Formats   []string // reports.FORMAT_*, default PDF only
+	Explain   bool     // add the records behind the summary to the PDF
+	Verify    bool     // verify the balances against the chain node
+	Strict    bool     // fail the reports with the texts missing in the locale
+	Timezone  string   // IANA name of the time zone of the period and the dates, default UTC
+	Addresses []string // if empty, all the active addresses of the period are used
+	OutputDir string   // reports are written to OutputDir/<period.Dir()>/<address>-<locale><extension of the format>
+	Workers   int
+	Force     bool // regenerate the reports that are already done
+}
+
+type job struct {
+	address string
+	locale  string
+}
+
+// PeriodDir returns the directory of the reports and the manifest for the period
+func PeriodDir(root string, p period.Period) string {
+	return filepath.Join(root, filepath.FromSlash(p.Dir()))
+}
+
+// Run generates the reports of the period and records the results in the manifest.
+// Reports that succeeded in a previous run are skipped unless opts.Force is set.
+func Run(cfg *cmn.Config, opts Options) (*Manifest, error) {
+	if !opts.Period.From.Before(opts.Period.To) {
+		return nil, fmt.Errorf("invalid period: %s", opts.Period)
+	}
+
+	loc, err := time.LoadLocation(opts.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %q: %w", opts.Timezone, err)
+	}
+	opts.Timezone = loc.String() // "UTC" for the empty name
+
+	if opts.Currency == "" {
+		opts.Currency = cfg.Currency
+	}
+	opts.Currency = strings.ToUpper(opts.Currency) // as in the manifest
+
+	if opts.Workers < 1 {
+		opts.Workers = 1
+	}
+
+	if len(opts.Formats) == 0 {
+		opts.Formats = []string{reports.FORMAT_PDF}
+	}
+
+	dir := PeriodDir(opts.OutputDir, opts.Period)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create output directory %s: %w", dir, err)
+	}
+
+	manifest, err := LoadManifest(dir, opts.Period)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := opts.Addresses
+	if len(addresses) == 0 {
+		src, err := data.NewSource(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		local := opts.Period.In(loc)
+		active, err := src.GetActiveAddresses(local.From, local.To)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get active addresses: %w", err)
+		}
+
+		// the database may keep an address in several cases, the files and the manifest use the checksummed one
+		for _, a := range active {
+			addr, err := cmn.ParseAddress(a)
+			if err != nil {
+				log.Warn().Err(err).Msg("Skipping active address")
+				continue
+			}
+			if !slices.Contains(addresses, addr) {
+				addresses = append(addresses, addr)
+			}
+		}
+	}
+	manifest.selectRun(addresses, opts.Locales)
+
+	var jobs []job
+	for _, addr := range addresses {
+		for _, locale := range opts.Locales {
+			if !opts.Force && manifest.Done(addr, locale, fileNames(addr, locale, opts.Formats), &opts) {
+				continue
+			}
+			jobs = append(jobs, job{address: addr, locale: locale})
+		}
+	}
+
+	log.Info().Msgf("Batch %s: %d addresses, %d reports to generate", opts.Period, len(addresses), len(jobs))
+
+	queue := make(chan job)
+	var wg sync.WaitGroup
+
+	for i := 0; i < opts.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range queue {
+				e := generate(cfg, dir, opts, j)
+				if err := manifest.Record(e); err != nil {
+					log.Error().Err(err).Msg("Failed to save manifest")
+				}
+			}
+		}()
+	}
+
+	for _, j := range jobs {
+		queue <- j
+	}
+	close(queue)
+	wg.Wait()
+
+	return manifest, nil
+}
+
+// fileNames returns the names of the report files in every format
+func fileNames(address, locale string, formats []string) []string {
+	files := make([]string, 0, len(formats))
+	for _, format := range formats {
+		files = append(files, fmt.Sprintf("%s-%s%s", address, locale, reports.Extensions[format]))
+	}
+	return files
+}
+
+func generate(cfg *cmn.Config, dir string, opts Options, j job) *Entry {
+	files := fileNames(j.address, j.locale, opts.Formats)
+	start := time.Now()
+
+	// the extension is replaced for every format
+	verified, err := reports.BuildReport(cfg, j.address, opts.Period, filepath.Join(dir, files[0]), opts.Formats, reports.Options{
+		Locale:   j.locale,
+		Currency: opts.Currency,
+		Explain:  opts.Explain,
+		Verify:   opts.Verify,
+		Strict:   opts.Strict,
+		Timezone: opts.Timezone,
+	})
+
+	e := &Entry{
+		Address:    j.address,
+		Locale:     j.locale,
+		Status:     STATUS_OK,
+		Files:      files,
+		Timezone:   opts.Timezone,
+		Currency:   opts.Currency,
+		Explain:    opts.Explain,
+		Strict:     opts.Strict,
+		Duration:   time.Since(start).Seconds(),
+		FinishedAt: time.Now().UTC(),
+	}
+
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to build report for %s (%s)", j.address, j.locale)
+		e.Status = STATUS_FAILED
+		e.Error = err.Error()
+	} else {
+		log.Info().Msgf("Report saved to %s: %s", dir, strings.Join(files, ", "))
+	}
+
+	if verified != nil {
+		e.Verified = true
+		for _, c := range verified.Discrepancies() {
+			e.Discrepancies = append(e.Discrepancies, Discrepancy{
+				Balance:  c.Balance,
+				Boundary: c.Boundary,
+				Block:    c.Block,
+				History:  c.History.String(),
+				Chain:    c.Chain.String(),
+			})
+		}
+
+		if len(e.Discrepancies) > 0 {
+			log.Warn().Msgf("The balances of %s differ from the chain in %d checks", j.address, len(e.Discrepancies))
+		}
+	}
+
+	return e
+}
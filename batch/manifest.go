@@ -0,0 +1,191 @@
+package batch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/AlexNa-Holdings/savva-reports/period"
+)
+
+const (
+	STATUS_OK     = "ok"
+	STATUS_FAILED = "failed"
+)
+
+const MANIFEST_FILE = "manifest.json"
+
+// Entry is the result of one report generation
+type Entry struct {
+	Address       string        `json:"address"`
+	Locale        string        `json:"locale"`
+	Status        string        `json:"status"`
+	Files         []string      `json:"files,omitempty"`
+	Error         string        `json:"error,omitempty"`
+	Timezone      string        `json:"timezone,omitempty"` // absent in the manifests of the UTC only versions
+	Currency      string        `json:"currency,omitempty"` // ISO code, absent in the manifests of the older versions
+	Explain       bool          `json:"explain,omitempty"`  // the PDF has the records behind the summary
+	Strict        bool          `json:"strict,omitempty"`   // the texts were checked for the missing translations
+	Verified      bool          `json:"verified,omitempty"` // the balances were verified against the chain node
+	Discrepancies []Discrepancy `json:"discrepancies,omitempty"`
+	Duration      float64       `json:"duration_sec"`
+	FinishedAt    time.Time     `json:"finished_at"`
+}
+
+// Discrepancy is a balance derived from the history that differs from the contract
+type Discrepancy struct {
+	Balance  string `json:"balance"`  // wallet or staked
+	Boundary string `json:"boundary"` // opening or closing
+	Block    uint64 `json:"block"`
+	History  string `json:"history"` // wei
+	Chain    string `json:"chain"`   // wei
+}
+
+// Manifest keeps the results of all the reports of one period. It is saved
+// after every finished report, so an interrupted batch can be resumed.
+type Manifest struct {
+	Period    string            `json:"period"` // as accepted by period.Parse
+	UpdatedAt time.Time         `json:"updated_at"`
+	Entries   map[string]*Entry `json:"entries"` // key -> entry
+
+	path string
+	run  map[string]bool // keys of the reports of the current run, see selectRun
+	mu   sync.Mutex
+}
+
+func entryKey(address, locale string) string {
+	return address + "-" + locale
+}
+
+// LoadManifest reads the manifest from the directory or returns an empty one if there is none yet
+func LoadManifest(dir string, p period.Period) (*Manifest, error) {
+	m := &Manifest{
+		Period:  p.String(),
+		Entries: make(map[string]*Entry),
+		path:    filepath.Join(dir, MANIFEST_FILE),
+	}
+
+	content, err := os.ReadFile(m.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return m, nil
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal(content, m); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest %s: %w", m.path, err)
+	}
+
+	if m.Entries == nil {
+		m.Entries = make(map[string]*Entry)
+	}
+
+	return m, nil
+}
+
+// Done reports whether the report was already generated successfully with the time zone,
+// the currency and the content of the options, verified and strict if they are requested,
+// and all the files are still there
+func (m *Manifest) Done(address, locale string, files []string, opts *Options) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.Entries[entryKey(address, locale)]
+	if !ok || e.Status != STATUS_OK || (opts.Verify && !e.Verified) || (opts.Strict && !e.Strict) {
+		return false
+	}
+
+	if tz := e.Timezone; tz != opts.Timezone && (tz != "" || opts.Timezone != "UTC") {
+		return false
+	}
+
+	if e.Currency != opts.Currency || e.Explain != opts.Explain {
+		return false
+	}
+
+	for _, file := range files {
+		if !slices.Contains(e.Files, file) {
+			return false
+		}
+
+		if _, err := os.Stat(filepath.Join(filepath.Dir(m.path), file)); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Record stores the entry and saves the manifest
+func (m *Manifest) Record(e *Entry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Entries[entryKey(e.Address, e.Locale)] = e
+	return m.save()
+}
+
+// selectRun limits RunEntries, Failed and WithDiscrepancies to the reports of the
+// current run, the manifest keeps the entries of the previous runs as well
+func (m *Manifest) selectRun(addresses, locales []string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.run = make(map[string]bool)
+	for _, addr := range addresses {
+		for _, locale := range locales {
+			m.run[entryKey(addr, locale)] = true
+		}
+	}
+}
+
+// filter returns the entries of the current run, or all of them if none is selected
+func (m *Manifest) filter(keep func(e *Entry) bool) []*Entry {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var r []*Entry
+	for key, e := range m.Entries {
+		if (m.run == nil || m.run[key]) && keep(e) {
+			r = append(r, e)
+		}
+	}
+	return r
+}
+
+// RunEntries returns the entries of the current run
+func (m *Manifest) RunEntries() []*Entry {
+	return m.filter(func(e *Entry) bool { return true })
+}
+
+// Failed returns the failed entries of the current run
+func (m *Manifest) Failed() []*Entry {
+	return m.filter(func(e *Entry) bool { return e.Status == STATUS_FAILED })
+}
+
+// WithDiscrepancies returns the entries of the current run whose balances differ from the chain
+func (m *Manifest) WithDiscrepancies() []*Entry {
+	return m.filter(func(e *Entry) bool { return len(e.Discrepancies) > 0 })
+}
+
+func (m *Manifest) save() error {
+	m.UpdatedAt = time.Now().UTC()
+
+	content, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first, so a crash never leaves a broken manifest
+	tmp := m.path + ".tmp"
+	if err := os.WriteFile(tmp, content, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, m.path)
+}
@@ -0,0 +1,87 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Backend is the part of the node API the reports use. It is implemented by
+// ethclient.Client and by the client of the simulated backend.
+type Backend interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+// Dial connects to the node by its RPC URL
+func Dial(url string) (*ethclient.Client, error) {
+	client, err := ethclient.Dial(url)
+	if err != nil {
+		return nil, fmt.Errorf("cannot connect to %s: %w", url, err)
+	}
+	return client, nil
+}
+
+// BlockAt returns the last block mined at or before t, the genesis block if t is before it
+func BlockAt(ctx context.Context, b Backend, t time.Time) (*types.Header, error) {
+	latest, err := b.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the latest block: %w", err)
+	}
+
+	if t.Unix() < 0 {
+		return b.HeaderByNumber(ctx, big.NewInt(0))
+	}
+
+	target := uint64(t.Unix())
+	if latest.Time <= target {
+		return latest, nil
+	}
+
+	// the timestamps grow with the block numbers
+	lo, hi := uint64(0), latest.Number.Uint64() // block lo is a candidate, hi is too late
+	var found *types.Header
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		h, err := b.HeaderByNumber(ctx, new(big.Int).SetUint64(mid))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get block %d: %w", mid, err)
+		}
+
+		if h.Time <= target {
+			found = h
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+
+	if found == nil {
+		return b.HeaderByNumber(ctx, big.NewInt(0))
+	}
+	return found, nil
+}
+
+// balanceOf(address) of ERC-20
+var balanceOfSelector = []byte{0x70, 0xa0, 0x82, 0x31}
+
+// BalanceOf returns the ERC-20 balance of the account at the block
+func BalanceOf(ctx context.Context, b Backend, contract, account common.Address, block *big.Int) (*big.Int, error) {
+	input := append(append([]byte{}, balanceOfSelector...), common.LeftPadBytes(account.Bytes(), 32)...)
+
+	out, err := b.CallContract(ctx, ethereum.CallMsg{To: &contract, Data: input}, block)
+	if err != nil {
+		return nil, fmt.Errorf("balanceOf %s at %s: %w", account, contract, err)
+	}
+
+	if len(out) != 32 {
+		return nil, fmt.Errorf("balanceOf %s at %s: unexpected result of %d bytes", account, contract, len(out))
+	}
+	return new(big.Int).SetBytes(out), nil
+}
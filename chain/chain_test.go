@@ -0,0 +1,124 @@
+package chain
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+)
+
+var (
+	TOKEN   = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	HOLDER  = common.HexToAddress("0xDf691828859e3Cb1e31E6D2F8A9b04F3B91A717f")
+	BALANCE = new(big.Int).Mul(big.NewInt(163_250), big.NewInt(1e18))
+)
+
+// TOKEN_CODE answers balanceOf(address) with the storage slot of the address:
+// PUSH1 4 CALLDATALOAD SLOAD PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+var TOKEN_CODE = common.FromHex("0x6004355460005260206000f3")
+
+// newBackend returns the simulated chain with the token and the blocks an hour apart
+func newBackend(t *testing.T, blocks int) *simulated.Backend {
+	t.Helper()
+
+	b := simulated.NewBackend(types.GenesisAlloc{
+		TOKEN: {
+			Code:    TOKEN_CODE,
+			Balance: big.NewInt(0),
+			Storage: map[common.Hash]common.Hash{
+				common.BytesToHash(HOLDER.Bytes()): common.BigToHash(BALANCE),
+			},
+		},
+	})
+	t.Cleanup(func() { b.Close() })
+
+	for range blocks {
+		if err := b.AdjustTime(time.Hour); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return b
+}
+
+func TestBlockAt(t *testing.T) {
+	const BLOCKS = 9
+	ctx := context.Background()
+	client := newBackend(t, BLOCKS).Client()
+
+	var times []uint64 // of the blocks by number
+	for i := range BLOCKS + 1 {
+		h, err := client.HeaderByNumber(ctx, big.NewInt(int64(i)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		times = append(times, h.Time)
+	}
+	at := func(s uint64) time.Time { return time.Unix(int64(s), 0) }
+
+	tests := []struct {
+		name  string
+		t     time.Time
+		block uint64
+	}{
+		{"before the genesis", at(times[0]).Add(-time.Hour), 0},
+		{"genesis", at(times[0]), 0},
+		{"after the genesis", at(times[0] + 1), 0},
+		{"first", at(times[1]), 1},
+		{"before the second", at(times[2] - 1), 1},
+		{"middle", at(times[5]), 5},
+		{"between", at(times[5] + 10), 5},
+		{"before the last", at(times[BLOCKS] - 1), BLOCKS - 1},
+		{"last", at(times[BLOCKS]), BLOCKS},
+		{"after the last", at(times[BLOCKS]).Add(24 * time.Hour), BLOCKS},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := BlockAt(ctx, client, tt.t)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := h.Number.Uint64(); got != tt.block {
+				t.Errorf("BlockAt(%d) = %d, want %d", tt.t.Unix(), got, tt.block)
+			}
+		})
+	}
+}
+
+func TestBalanceOf(t *testing.T) {
+	ctx := context.Background()
+	client := newBackend(t, 2).Client()
+
+	tests := []struct {
+		name    string
+		account common.Address
+		block   *big.Int
+		want    *big.Int
+	}{
+		{"genesis", HOLDER, big.NewInt(0), BALANCE},
+		{"block", HOLDER, big.NewInt(2), BALANCE},
+		{"latest", HOLDER, nil, BALANCE},
+		{"no balance", common.HexToAddress("0x01"), nil, big.NewInt(0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BalanceOf(ctx, client, TOKEN, tt.account, tt.block)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Cmp(tt.want) != 0 {
+				t.Errorf("BalanceOf(%s) = %s, want %s", tt.account, got, tt.want)
+			}
+		})
+	}
+
+	// an account without the code returns nothing
+	if _, err := BalanceOf(ctx, client, common.HexToAddress("0x00000000000000000000000000000000000000bb"), HOLDER, nil); err == nil {
+		t.Error("BalanceOf of a contract without the code succeeded")
+	}
+}
@@ -0,0 +1,178 @@
+package classify
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	DIRECTION_IN  = "in"  // to the user
+	DIRECTION_OUT = "out" // from the user
+
+	ANY_TYPE = "*"
+
+	// OTHER collects the records that no rule matches
+	OTHER = "other"
+)
+
+//go:embed default.yaml
+var defaultRules []byte
+
+// Category is a row of the summary
+type Category struct {
+	Key  string `yaml:"key"`
+	I18n string `yaml:"i18n"` // key of the description, default summary.<key>
+
+	// how the signed amounts of the category change the balances: 1, -1 or 0 if not at all
+	Wallet int `yaml:"wallet"`
+	Staked int `yaml:"staked"`
+}
+
+// Rule assigns the matching history records to the category
+type Rule struct {
+	Contract  string `yaml:"contract"`
+	Type      string `yaml:"type"`
+	Direction string `yaml:"direction"` // in, out or empty for both
+	Category  string `yaml:"category"`
+	Sign      int    `yaml:"sign"` // 1 or -1, default 1 for in and -1 for out
+}
+
+// Rules is the classification table. The categories are in the order of the summary.
+type Rules struct {
+	Categories []Category `yaml:"categories"`
+	Rules      []Rule     `yaml:"rules"`
+}
+
+// Default returns the built-in classification
+var Default = sync.OnceValue(func() *Rules {
+	r, err := Parse(defaultRules)
+	if err != nil {
+		panic(fmt.Sprintf("invalid default classification: %v", err))
+	}
+	return r
+})
+
+// Load reads the classification from the YAML file
+func Load(path string) (*Rules, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return r, nil
+}
+
+func Parse(content []byte) (*Rules, error) {
+	r := &Rules{}
+	if err := yaml.UnmarshalStrict(content, r); err != nil {
+		return nil, fmt.Errorf("failed to parse classification: %w", err)
+	}
+
+	if err := r.init(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// init validates the rules and fills in the defaults
+func (r *Rules) init() error {
+	var errs []error
+
+	if len(r.Categories) == 0 {
+		errs = append(errs, errors.New("no categories defined"))
+	}
+
+	keys := make(map[string]bool)
+	for i := range r.Categories {
+		c := &r.Categories[i]
+		if c.Key == "" {
+			errs = append(errs, fmt.Errorf("category %d: key is not set", i+1))
+			continue
+		}
+		if keys[c.Key] {
+			errs = append(errs, fmt.Errorf("category %s is defined twice", c.Key))
+		}
+		keys[c.Key] = true
+
+		if c.I18n == "" {
+			c.I18n = "summary." + c.Key
+		}
+
+		if c.Wallet < -1 || c.Wallet > 1 || c.Staked < -1 || c.Staked > 1 {
+			errs = append(errs, fmt.Errorf("category %s: wallet and staked must be 1, -1 or 0", c.Key))
+		}
+	}
+
+	// the unclassified records are always collected
+	if !keys[OTHER] {
+		r.Categories = append(r.Categories, Category{Key: OTHER, I18n: "summary." + OTHER})
+		keys[OTHER] = true
+	}
+
+	for i, rule := range r.Rules {
+		if rule.Contract == "" || rule.Type == "" {
+			errs = append(errs, fmt.Errorf("rule %d: contract and type are required", i+1))
+		}
+
+		switch rule.Direction {
+		case "", DIRECTION_IN, DIRECTION_OUT:
+		default:
+			errs = append(errs, fmt.Errorf("rule %d: invalid direction %q", i+1, rule.Direction))
+		}
+
+		if !keys[rule.Category] {
+			errs = append(errs, fmt.Errorf("rule %d: unknown category %q", i+1, rule.Category))
+		}
+
+		if rule.Sign != 0 && rule.Sign != 1 && rule.Sign != -1 {
+			errs = append(errs, fmt.Errorf("rule %d: sign must be 1 or -1", i+1))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Effect returns how the signed amount of the category changes the wallet and the staked balances
+func (r *Rules) Effect(category string) (wallet, staked int) {
+	for _, c := range r.Categories {
+		if c.Key == category {
+			return c.Wallet, c.Staked
+		}
+	}
+	return 0, 0
+}
+
+// Classify returns the category of the record and the sign of its amount in the category.
+// out is true if the record was sent by the user.
+func (r *Rules) Classify(contract, typ string, out bool) (string, int) {
+	direction, sign := DIRECTION_IN, 1
+	if out {
+		direction, sign = DIRECTION_OUT, -1
+	}
+
+	for _, rule := range r.Rules {
+		if rule.Contract != contract || (rule.Type != typ && rule.Type != ANY_TYPE) {
+			continue
+		}
+
+		if rule.Direction != "" && rule.Direction != direction {
+			continue
+		}
+
+		if rule.Sign != 0 {
+			sign = rule.Sign
+		}
+		return rule.Category, sign
+	}
+
+	return OTHER, sign
+}
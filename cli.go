@@ -0,0 +1,507 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/AlexNa-Holdings/savva-reports/batch"
+	"github.com/AlexNa-Holdings/savva-reports/classify"
+	"github.com/AlexNa-Holdings/savva-reports/cmn"
+	"github.com/AlexNa-Holdings/savva-reports/currency"
+	"github.com/AlexNa-Holdings/savva-reports/i18n"
+	"github.com/AlexNa-Holdings/savva-reports/period"
+	"github.com/AlexNa-Holdings/savva-reports/reports"
+	"github.com/AlexNa-Holdings/savva-reports/server"
+	"github.com/rs/zerolog/log"
+)
+
+// Exit codes returned to the schedulers
+const (
+	exitOK      = 0
+	exitFailure = 1 // report generation failed
+	exitUsage   = 2 // invalid flags or arguments
+	exitConfig  = 3 // config file is missing, invalid or the backends are unreachable
+)
+
+const DEFAULT_CONFIG = "savva-reports.yaml"
+
+// envOr returns the value of the environment variable or def if it is not set
+func envOr(key, def string) string {
+	if v, ok := os.LookupEnv(key); ok && v != "" {
+		return v
+	}
+	return def
+}
+
+func configFlag(fs *flag.FlagSet) *string {
+	return fs.String("config", envOr("SAVVA_REPORTS_CONFIG", DEFAULT_CONFIG), "path to the config file (env SAVVA_REPORTS_CONFIG)")
+}
+
+func periodFlag(fs *flag.FlagSet) *string {
+	return fs.String("period", envOr("SAVVA_REPORTS_PERIOD", ""), "report period: "+period.FORMATS+" (env SAVVA_REPORTS_PERIOD)")
+}
+
+func localeFlag(fs *flag.FlagSet) *string {
+	return fs.String("locale", envOr("SAVVA_REPORTS_LOCALE", "en"), "report language (env SAVVA_REPORTS_LOCALE)")
+}
+
+func currencyFlag(fs *flag.FlagSet) *string {
+	return fs.String("currency", envOr("SAVVA_REPORTS_CURRENCY", ""), "ISO code of the fiat currency, default from the config (env SAVVA_REPORTS_CURRENCY)")
+}
+
+func formatFlag(fs *flag.FlagSet) *string {
+	return fs.String("format", envOr("SAVVA_REPORTS_FORMAT", reports.FORMAT_PDF), "comma separated output formats: pdf, csv, json, explain (env SAVVA_REPORTS_FORMAT)")
+}
+
+func explainFlag(fs *flag.FlagSet) *bool {
+	return fs.Bool("explain", false, "add the records behind every summary figure to the PDF")
+}
+
+func timezoneFlag(fs *flag.FlagSet) *string {
+	return fs.String("timezone", envOr("SAVVA_REPORTS_TIMEZONE", "UTC"), "IANA time zone of the period and the dates, e.g. Asia/Vladivostok (env SAVVA_REPORTS_TIMEZONE)")
+}
+
+func checkTimezone(name string) error {
+	if _, err := time.LoadLocation(name); err != nil {
+		return fmt.Errorf("unknown time zone %q", name)
+	}
+	return nil
+}
+
+func strictFlag(fs *flag.FlagSet) *bool {
+	return fs.Bool("strict", false, "fail if a text of the report has no translation to the locale")
+}
+
+func verifyFlag(fs *flag.FlagSet) *bool {
+	return fs.Bool("verify", false, "verify the balances against the chain node of the config")
+}
+
+// checkVerify fails if the verification is requested but the chain node is not configured
+func checkVerify(verify bool, cfg *cmn.Config) int {
+	if verify && cfg.Chain == nil {
+		log.Error().Msg("-verify requires the chain section in the config")
+		return exitConfig
+	}
+	return exitOK
+}
+
+func parseFormats(s string) ([]string, error) {
+	var formats []string
+	for _, f := range strings.Split(s, ",") {
+		f = strings.ToLower(strings.TrimSpace(f))
+		if err := reports.CheckFormat(f); err != nil {
+			return nil, err
+		}
+		if !slices.Contains(formats, f) {
+			formats = append(formats, f)
+		}
+	}
+	return formats, nil
+}
+
+func checkCurrency(code string) error {
+	if code == "" {
+		return nil
+	}
+	if _, err := currency.Get(code); err != nil {
+		return fmt.Errorf("%w, supported: %s", err, strings.Join(currency.Codes(), ", "))
+	}
+	return nil
+}
+
+func checkLocale(locale string, translations *i18n.Catalog) error {
+	if !translations.Has(locale) {
+		return fmt.Errorf("unsupported locale %q, run list-locales to see the available ones", locale)
+	}
+	return nil
+}
+
+// setup loads the config, validates it and connects to the backends
+func setup(path string) (*AppConfig, *cmn.Config, int) {
+	config, err := loadConfig(path)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load config")
+		return nil, nil, exitConfig
+	}
+
+	if err := config.Validate(); err != nil {
+		log.Error().Err(err).Msgf("Invalid config file %s", path)
+		return nil, nil, exitConfig
+	}
+
+	cfg, err := config.apply()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to initialize backends")
+		return nil, nil, exitConfig
+	}
+
+	return config, cfg, exitOK
+}
+
+func usageError(fs *flag.FlagSet, err error) int {
+	fmt.Fprintf(fs.Output(), "%s: %v\n", fs.Name(), err)
+	fs.Usage()
+	return exitUsage
+}
+
+func cmdGenerate(args []string) int {
+	fs := flag.NewFlagSet("generate", flag.ContinueOnError)
+	config := configFlag(fs)
+	address := fs.String("address", envOr("SAVVA_REPORTS_ADDRESS", ""), "user address (env SAVVA_REPORTS_ADDRESS)")
+	periodStr := periodFlag(fs)
+	locale := localeFlag(fs)
+	cur := currencyFlag(fs)
+	format := formatFlag(fs)
+	explain := explainFlag(fs)
+	verify := verifyFlag(fs)
+	strict := strictFlag(fs)
+	timezone := timezoneFlag(fs)
+	output := fs.String("output", envOr("SAVVA_REPORTS_OUTPUT", ""), "output file, the extension is replaced for every format, default <address>-<period>-<locale>.pdf (env SAVVA_REPORTS_OUTPUT)")
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	addr, err := cmn.ParseAddress(*address)
+	if err != nil {
+		return usageError(fs, err)
+	}
+
+	p, err := period.Parse(*periodStr)
+	if err != nil {
+		return usageError(fs, err)
+	}
+
+	if err := checkCurrency(*cur); err != nil {
+		return usageError(fs, err)
+	}
+
+	if err := checkTimezone(*timezone); err != nil {
+		return usageError(fs, err)
+	}
+
+	formats, err := parseFormats(*format)
+	if err != nil {
+		return usageError(fs, err)
+	}
+
+	if *output == "" {
+		*output = fmt.Sprintf("%s-%s-%s.pdf", addr, p.Slug(), *locale)
+	}
+
+	_, cfg, code := setup(*config)
+	if code != exitOK {
+		return code
+	}
+	defer cfg.Close()
+
+	if code := checkVerify(*verify, cfg); code != exitOK {
+		return code
+	}
+
+	if err := checkLocale(*locale, cfg.Locales()); err != nil {
+		return usageError(fs, err)
+	}
+
+	verified, err := reports.BuildReport(cfg, addr, p, *output, formats, reports.Options{
+		Locale:   *locale,
+		Currency: *cur,
+		Explain:  *explain,
+		Verify:   *verify,
+		Strict:   *strict,
+		Timezone: *timezone,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to build report")
+		return exitFailure
+	}
+
+	if verified != nil {
+		for _, c := range verified.Discrepancies() {
+			log.Warn().Msgf("The %s %s balance at block %d differs from the chain: history %s, chain %s",
+				c.Boundary, c.Balance, c.Block, c.History, c.Chain)
+		}
+	}
+
+	for _, f := range formats {
+		log.Info().Msgf("Report saved to %s", reports.FormatPath(*output, f))
+	}
+	return exitOK
+}
+
+// readAddresses reads the addresses file, one address per line. Empty lines and lines starting with # are ignored
+func readAddresses(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var addresses []string
+	scanner := bufio.NewScanner(file)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		addr, err := cmn.ParseAddress(line)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, n, err)
+		}
+		addresses = append(addresses, addr)
+	}
+
+	return addresses, scanner.Err()
+}
+
+func cmdBatch(args []string) int {
+	fs := flag.NewFlagSet("batch", flag.ContinueOnError)
+	config := configFlag(fs)
+	addressesFile := fs.String("addresses", envOr("SAVVA_REPORTS_ADDRESSES", ""), "file with the user addresses, one per line; default all the active addresses of the period (env SAVVA_REPORTS_ADDRESSES)")
+	periodStr := periodFlag(fs)
+	locales := fs.String("locales", envOr("SAVVA_REPORTS_LOCALES", "en"), "comma separated report languages (env SAVVA_REPORTS_LOCALES)")
+	cur := currencyFlag(fs)
+	format := formatFlag(fs)
+	explain := explainFlag(fs)
+	verify := verifyFlag(fs)
+	strict := strictFlag(fs)
+	timezone := timezoneFlag(fs)
+	outputDir := fs.String("output-dir", envOr("SAVVA_REPORTS_OUTPUT_DIR", "."), "root directory of the reports tree (env SAVVA_REPORTS_OUTPUT_DIR)")
+	workers := fs.Int("workers", 4, "number of reports generated in parallel")
+	force := fs.Bool("force", false, "regenerate the reports that already succeeded")
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	var addresses []string
+	if *addressesFile != "" {
+		var err error
+		addresses, err = readAddresses(*addressesFile)
+		if err != nil {
+			return usageError(fs, err)
+		}
+	}
+
+	p, err := period.Parse(*periodStr)
+	if err != nil {
+		return usageError(fs, err)
+	}
+
+	if err := checkCurrency(*cur); err != nil {
+		return usageError(fs, err)
+	}
+
+	if err := checkTimezone(*timezone); err != nil {
+		return usageError(fs, err)
+	}
+
+	formats, err := parseFormats(*format)
+	if err != nil {
+		return usageError(fs, err)
+	}
+
+	if *workers < 1 {
+		return usageError(fs, errors.New("workers must be at least 1"))
+	}
+
+	_, cfg, code := setup(*config)
+	if code != exitOK {
+		return code
+	}
+	defer cfg.Close()
+
+	if code := checkVerify(*verify, cfg); code != exitOK {
+		return code
+	}
+
+	var localeList []string
+	for _, l := range strings.Split(*locales, ",") {
+		l = strings.TrimSpace(l)
+		if err := checkLocale(l, cfg.Locales()); err != nil {
+			return usageError(fs, err)
+		}
+		localeList = append(localeList, l)
+	}
+
+	manifest, err := batch.Run(cfg, batch.Options{
+		Period:    p,
+		Locales:   localeList,
+		Currency:  *cur,
+		Formats:   formats,
+		Explain:   *explain,
+		Verify:    *verify,
+		Strict:    *strict,
+		Timezone:  *timezone,
+		Addresses: addresses,
+		OutputDir: *outputDir,
+		Workers:   *workers,
+		Force:     *force,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Batch failed")
+		return exitFailure
+	}
+
+	if failed := manifest.Failed(); len(failed) > 0 {
+		log.Error().Msgf("%d of %d reports failed, rerun the batch to retry them", len(failed), len(manifest.RunEntries()))
+		return exitFailure
+	}
+
+	if d := manifest.WithDiscrepancies(); len(d) > 0 {
+		log.Warn().Msgf("The balances of %d reports differ from the chain, see %s", len(d), batch.MANIFEST_FILE)
+	}
+
+	return exitOK
+}
+
+func cmdServe(args []string) int {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	config := configFlag(fs)
+	listen := fs.String("listen", envOr("SAVVA_REPORTS_LISTEN", ":8080"), "address to listen on (env SAVVA_REPORTS_LISTEN)")
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	app, cfg, code := setup(*config)
+	if code != exitOK {
+		return code
+	}
+	defer cfg.Close()
+
+	if err := app.Auth.Validate(); err != nil {
+		log.Error().Err(err).Msgf("Invalid config file %s", *config)
+		return exitConfig
+	}
+
+	if len(app.Auth.Admins) == 0 {
+		log.Warn().Msg("No admin addresses configured, reports are served only to their owners")
+	}
+
+	if err := server.New(cfg, app.Auth).ListenAndServe(*listen); err != nil {
+		log.Error().Err(err).Msg("Report service stopped")
+		return exitFailure
+	}
+
+	return exitOK
+}
+
+func cmdValidateConfig(args []string) int {
+	fs := flag.NewFlagSet("validate-config", flag.ContinueOnError)
+	config := configFlag(fs)
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	_, cfg, code := setup(*config)
+	if code != exitOK {
+		return code
+	}
+	defer cfg.Close()
+
+	fmt.Printf("%s: OK\n", *config)
+	return exitOK
+}
+
+func cmdListLocales(args []string) int {
+	fs := flag.NewFlagSet("list-locales", flag.ContinueOnError)
+	dir := translationsFlag(fs)
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	translations, err := loadTranslations(*dir)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load translations")
+		return exitConfig
+	}
+
+	for _, l := range translations.Codes() {
+		fmt.Println(l)
+	}
+	return exitOK
+}
+
+func translationsFlag(fs *flag.FlagSet) *string {
+	return fs.String("translations", envOr("SAVVA_REPORTS_TRANSLATIONS", ""), "directory of the translation files overriding the built-in ones, see translations_dir of the config (env SAVVA_REPORTS_TRANSLATIONS)")
+}
+
+// loadTranslations returns the built-in translations if the directory is not set
+func loadTranslations(dir string) (*i18n.Catalog, error) {
+	if dir == "" {
+		return i18n.Default(), nil
+	}
+	return i18n.Load(dir)
+}
+
+// cmdCheckTranslations lists the keys missing or unused in every language
+func cmdCheckTranslations(args []string) int {
+	fs := flag.NewFlagSet("check-translations", flag.ContinueOnError)
+	dir := translationsFlag(fs)
+	src := fs.String("src", ".", "root of the source tree, the reports and pdf packages are scanned")
+	classification := fs.String("classification", "", "classification file whose category descriptions are used, default built-in")
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	translations, err := loadTranslations(*dir)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load translations")
+		return exitConfig
+	}
+
+	rules := classify.Default()
+	if *classification != "" {
+		if rules, err = classify.Load(*classification); err != nil {
+			log.Error().Err(err).Msg("Failed to load classification")
+			return exitConfig
+		}
+	}
+
+	keys, err := i18n.ScanKeys(filepath.Join(*src, "reports"), filepath.Join(*src, "pdf"))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to scan the sources")
+		return exitFailure
+	}
+
+	// the descriptions of the categories are looked up by the keys of the classification
+	for _, c := range rules.Categories {
+		if _, ok := keys[c.I18n]; !ok {
+			keys[c.I18n] = "classification: " + c.Key
+		}
+	}
+
+	used := slices.Sorted(maps.Keys(keys))
+	code := exitOK
+	usage := translations.Check(used)
+
+	for _, l := range translations.Codes() {
+		u := usage[l]
+		fmt.Printf("%s: %d missing, %d unused\n", l, len(u.Missing), len(u.Unused))
+		for _, key := range u.Missing {
+			fmt.Printf("  missing %s (%s)\n", key, keys[key])
+		}
+		for _, key := range u.Unused {
+			fmt.Printf("  unused  %s\n", key)
+		}
+
+		if len(u.Missing) > 0 {
+			code = exitFailure
+		}
+	}
+
+	return code
+}
@@ -2,9 +2,12 @@ package cmn
 
 import (
 	"container/list"
+	"sync"
 )
 
+// Cache is a goroutine-safe LRU cache
 type Cache[K comparable, V any] struct {
+	mu        sync.Mutex
 	capacity  int
 	items     map[K]*list.Element
 	evictList *list.List
@@ -24,6 +27,9 @@ func NewCache[K comparable, V any](capacity int) *Cache[K, V] {
 }
 
 func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	var zero V
 	if elem, found := c.items[key]; found {
 		c.evictList.MoveToFront(elem)
@@ -33,6 +39,9 @@ func (c *Cache[K, V]) Get(key K) (V, bool) {
 }
 
 func (c *Cache[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	if elem, found := c.items[key]; found {
 		c.evictList.MoveToFront(elem)
 		elem.Value.(*entry[K, V]).value = value
@@ -58,6 +67,9 @@ func (c *Cache[K, V]) evict() {
 }
 
 func (c *Cache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	c.evictList.Init()
 	c.items = make(map[K]*list.Element)
 }
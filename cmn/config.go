@@ -3,6 +3,11 @@ package cmn
 import (
 	"database/sql"
 
+	"github.com/AlexNa-Holdings/savva-reports/chain"
+	"github.com/AlexNa-Holdings/savva-reports/classify"
+	"github.com/AlexNa-Holdings/savva-reports/i18n"
+	"github.com/AlexNa-Holdings/savva-reports/price"
+
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -32,19 +37,47 @@ import (
 
 type ContractAddresses struct {
 	ContentNFT common.Address
+	SavvaToken common.Address
+	Staking    common.Address
 }
 
+// Config is shared by all the reports rendered by the process and must not
+// be modified once the rendering has started
 type Config struct {
-	DB              *sql.DB
-	SavvaTokenPrice float64
-	CurrencySymbol  string
-	IPFS            func(cid string) []byte
+	DB             *sql.DB
+	Price          price.Provider  // USD price of the SAVVA token
+	FX             price.Rates     // USD exchange rates, nil if only USD is available
+	Currency       string          // ISO code of the currency used if the report does not select one
+	Classification *classify.Rules // categories of the history records
+	Translations   *i18n.Catalog   // report languages, nil for the built-in ones
+	IPFS           func(cid string) []byte
+	FixturesDir    string // if set, the data is loaded from the fixture files instead of DB
+
+	Chain     chain.Backend // node used to verify the balances, nil if not configured
+	Contracts ContractAddresses
+}
+
+// Locales returns the translations of the reports
+func (c *Config) Locales() *i18n.Catalog {
+	if c.Translations == nil {
+		return i18n.Default()
+	}
+	return c.Translations
 }
 
-var (
+func (c *Config) Close() error {
+	if closer, ok := c.Chain.(interface{ Close() }); ok {
+		closer.Close()
+	}
+
+	if c.DB == nil {
+		return nil
+	}
+	return c.DB.Close()
+}
+
+const (
 	Margin     = 40.0
 	PageWidth  = 595.28
 	PageHeight = 841.89
 )
-
-var C *Config = &Config{}
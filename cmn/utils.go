@@ -2,13 +2,29 @@ package cmn
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"image"
 	"image/color"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
-func LoadImage(cid string) (image.Image, error) {
-	content := C.IPFS(cid)
+// ParseAddress validates the address and returns it in the checksummed form
+func ParseAddress(address string) (string, error) {
+	if address == "" {
+		return "", errors.New("address is not set")
+	}
+
+	if !common.IsHexAddress(address) {
+		return "", fmt.Errorf("invalid address %q", address)
+	}
+
+	return common.HexToAddress(address).Hex(), nil
+}
+
+func (c *Config) LoadImage(cid string) (image.Image, error) {
+	content := c.IPFS(cid)
 	if content == nil {
 		return nil, fmt.Errorf("failed to load content for post %s", cid)
 	}
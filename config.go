@@ -0,0 +1,264 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/AlexNa-Holdings/savva-reports/chain"
+	"github.com/AlexNa-Holdings/savva-reports/classify"
+	"github.com/AlexNa-Holdings/savva-reports/cmn"
+	"github.com/AlexNa-Holdings/savva-reports/currency"
+	"github.com/AlexNa-Holdings/savva-reports/data"
+	"github.com/AlexNa-Holdings/savva-reports/i18n"
+	"github.com/AlexNa-Holdings/savva-reports/price"
+	"github.com/AlexNa-Holdings/savva-reports/server"
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	SOURCE_POSTGRES = "postgres"
+	SOURCE_CSV      = "csv"
+)
+
+// SourceConfig selects where a history (token prices, exchange rates) is read from
+type SourceConfig struct {
+	Type  string `yaml:"type"`  // "postgres" or "csv"
+	Table string `yaml:"table"` // postgres table, the default depends on the history
+	File  string `yaml:"file"`  // csv file
+}
+
+func (s *SourceConfig) validate(name string, fixtures bool) error {
+	switch s.Type {
+	case SOURCE_POSTGRES:
+		if fixtures {
+			return fmt.Errorf("%s postgres cannot be used with fixtures_dir", name)
+		}
+	case SOURCE_CSV:
+		if s.File == "" {
+			return fmt.Errorf("%s.file is not set", name)
+		}
+	default:
+		return fmt.Errorf("unknown %s.type %q", name, s.Type)
+	}
+	return nil
+}
+
+// ChainConfig is the node and the contracts used to verify the balances
+type ChainConfig struct {
+	RPCURL    string `yaml:"rpc_url"`
+	Contracts struct {
+		SavvaToken string `yaml:"savva_token"`
+		Staking    string `yaml:"staking"`
+	} `yaml:"contracts"`
+}
+
+func (c *ChainConfig) validate() []error {
+	var errs []error
+
+	if c.RPCURL == "" {
+		errs = append(errs, errors.New("chain.rpc_url is not set"))
+	} else if u, err := url.Parse(c.RPCURL); err != nil || !slices.Contains([]string{"http", "https", "ws", "wss"}, u.Scheme) {
+		errs = append(errs, fmt.Errorf("chain.rpc_url must be an http(s) or ws(s) URL: %s", c.RPCURL))
+	}
+
+	if !common.IsHexAddress(c.Contracts.SavvaToken) {
+		errs = append(errs, fmt.Errorf("chain.contracts.savva_token: invalid address %q", c.Contracts.SavvaToken))
+	}
+	if !common.IsHexAddress(c.Contracts.Staking) {
+		errs = append(errs, fmt.Errorf("chain.contracts.staking: invalid address %q", c.Contracts.Staking))
+	}
+
+	return errs
+}
+
+// AppConfig is the content of the savva-reports.yaml file
+type AppConfig struct {
+	DBConnection string  `yaml:"db_connection"`
+	IPFSGateway  string  `yaml:"ipfs_gateway"`
+	TokenPrice   float64 `yaml:"token_price"`  // fixed token price, used if price_source is not set
+	Currency     string  `yaml:"currency"`     // ISO code of the default report currency
+	FixturesDir  string  `yaml:"fixtures_dir"` // render from the fixture files instead of DB and IPFS
+
+	ClassificationFile string `yaml:"classification_file"` // categories of the history records, default built-in
+	TranslationsDir    string `yaml:"translations_dir"`    // translation files overriding the built-in ones
+
+	PriceSource *SourceConfig `yaml:"price_source"` // USD token price history, table token_prices
+	FXSource    *SourceConfig `yaml:"fx_source"`    // USD exchange rates, table fx_rates
+
+	Chain *ChainConfig `yaml:"chain"` // balance verification, optional
+
+	Auth server.AuthConfig `yaml:"auth"` // report service authentication
+}
+
+func loadConfig(path string) (*AppConfig, error) {
+	yamlFile, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+
+	config := &AppConfig{
+		Currency: currency.USD,
+	}
+
+	err = yaml.Unmarshal(yamlFile, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal config file %s: %w", path, err)
+	}
+
+	return config, nil
+}
+
+func (c *AppConfig) Validate() error {
+	var errs []error
+
+	if c.FixturesDir != "" {
+		if info, err := os.Stat(c.FixturesDir); err != nil || !info.IsDir() {
+			errs = append(errs, fmt.Errorf("fixtures_dir is not a directory: %s", c.FixturesDir))
+		}
+	} else {
+		if c.DBConnection == "" {
+			errs = append(errs, errors.New("db_connection is not set"))
+		}
+
+		if c.IPFSGateway == "" {
+			errs = append(errs, errors.New("ipfs_gateway is not set"))
+		} else if !strings.HasPrefix(c.IPFSGateway, "http://") && !strings.HasPrefix(c.IPFSGateway, "https://") {
+			errs = append(errs, fmt.Errorf("ipfs_gateway must be an http(s) URL: %s", c.IPFSGateway))
+		}
+	}
+
+	if c.PriceSource == nil {
+		if c.TokenPrice <= 0 {
+			errs = append(errs, errors.New("token_price must be greater than 0 if price_source is not set"))
+		}
+	} else if err := c.PriceSource.validate("price_source", c.FixturesDir != ""); err != nil {
+		errs = append(errs, err)
+	}
+
+	if c.FXSource != nil {
+		if err := c.FXSource.validate("fx_source", c.FixturesDir != ""); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if cur, err := currency.Get(c.Currency); err != nil {
+		errs = append(errs, fmt.Errorf("currency: %w", err))
+	} else if cur.Code != currency.USD && c.FXSource == nil {
+		errs = append(errs, fmt.Errorf("currency %s requires fx_source", cur.Code))
+	}
+
+	if c.ClassificationFile != "" {
+		if _, err := classify.Load(c.ClassificationFile); err != nil {
+			errs = append(errs, fmt.Errorf("classification_file: %w", err))
+		}
+	}
+
+	if c.TranslationsDir != "" {
+		if _, err := i18n.Load(c.TranslationsDir); err != nil {
+			errs = append(errs, fmt.Errorf("translations_dir: %w", err))
+		}
+	}
+
+	if c.Chain != nil {
+		errs = append(errs, c.Chain.validate()...)
+	}
+
+	for _, addr := range c.Auth.Admins {
+		if !common.IsHexAddress(addr) {
+			errs = append(errs, fmt.Errorf("auth.admins: invalid address %q", addr))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// apply opens the database connection and returns the config for the reports
+func (c *AppConfig) apply() (*cmn.Config, error) {
+	cfg := &cmn.Config{
+		Currency:       strings.ToUpper(c.Currency),
+		Classification: classify.Default(),
+	}
+
+	if c.ClassificationFile != "" {
+		rules, err := classify.Load(c.ClassificationFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load classification: %w", err)
+		}
+		cfg.Classification = rules
+	}
+
+	if c.TranslationsDir != "" {
+		translations, err := i18n.Load(c.TranslationsDir)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load translations: %w", err)
+		}
+		cfg.Translations = translations
+	}
+
+	if c.FixturesDir != "" {
+		cfg.IPFS = data.FixtureIPFS(c.FixturesDir)
+		cfg.FixturesDir = c.FixturesDir
+	} else {
+		db, err := sql.Open("postgres", c.DBConnection)
+		if err != nil {
+			return nil, fmt.Errorf("cannot connect to DB: %w", err)
+		}
+
+		if err := db.Ping(); err != nil {
+			db.Close()
+			return nil, fmt.Errorf("cannot connect to DB: %w", err)
+		}
+
+		cfg.DB = db
+		cfg.IPFS = ipfsLoader(c.IPFSGateway)
+	}
+
+	var err error
+	switch {
+	case c.PriceSource == nil:
+		cfg.Price = price.Fixed(c.TokenPrice)
+	case c.PriceSource.Type == SOURCE_POSTGRES:
+		cfg.Price, err = price.NewPostgres(cfg.DB, c.PriceSource.Table)
+	default:
+		cfg.Price, err = price.NewCSV(c.PriceSource.File)
+	}
+
+	if err != nil {
+		cfg.Close()
+		return nil, fmt.Errorf("cannot initialize price source: %w", err)
+	}
+
+	switch {
+	case c.FXSource == nil:
+		// USD only
+	case c.FXSource.Type == SOURCE_POSTGRES:
+		cfg.FX, err = price.NewPostgresRates(cfg.DB, c.FXSource.Table)
+	default:
+		cfg.FX, err = price.NewCSVRates(c.FXSource.File)
+	}
+
+	if err != nil {
+		cfg.Close()
+		return nil, fmt.Errorf("cannot initialize exchange rates: %w", err)
+	}
+
+	if c.Chain != nil {
+		client, err := chain.Dial(c.Chain.RPCURL)
+		if err != nil {
+			cfg.Close()
+			return nil, err
+		}
+
+		cfg.Chain = client
+		cfg.Contracts.SavvaToken = common.HexToAddress(c.Chain.Contracts.SavvaToken)
+		cfg.Contracts.Staking = common.HexToAddress(c.Chain.Contracts.Staking)
+	}
+
+	return cfg, nil
+}
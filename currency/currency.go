@@ -0,0 +1,47 @@
+package currency
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const USD = "USD"
+
+// Currency describes how the amounts in the currency are written
+type Currency struct {
+	Code     string // ISO 4217
+	Symbol   string // its place depends on the language of the report, see i18n.Format
+	Decimals int    // minor units
+}
+
+// The report fonts have no glyphs for ₽ and ₴, so the abbreviations are used
+var Currencies = map[string]Currency{
+	"USD": {Code: "USD", Symbol: "$", Decimals: 2},
+	"EUR": {Code: "EUR", Symbol: "€", Decimals: 2},
+	"GBP": {Code: "GBP", Symbol: "£", Decimals: 2},
+	"CHF": {Code: "CHF", Symbol: "CHF", Decimals: 2},
+	"JPY": {Code: "JPY", Symbol: "¥", Decimals: 0},
+	"CNY": {Code: "CNY", Symbol: "CN¥", Decimals: 2},
+	"RUB": {Code: "RUB", Symbol: "руб", Decimals: 2},
+	"UAH": {Code: "UAH", Symbol: "грн", Decimals: 2},
+}
+
+// Get returns the currency by its ISO code
+func Get(code string) (Currency, error) {
+	c, ok := Currencies[strings.ToUpper(code)]
+	if !ok {
+		return Currency{}, fmt.Errorf("unsupported currency %q", code)
+	}
+	return c, nil
+}
+
+// Codes returns the codes of all the supported currencies
+func Codes() []string {
+	codes := make([]string, 0, len(Currencies))
+	for code := range Currencies {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+	return codes
+}
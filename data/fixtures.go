@@ -0,0 +1,382 @@
+package data
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/AlexNa-Holdings/savva-reports/assets"
+	"github.com/AlexNa-Holdings/savva-reports/cmn"
+	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v2"
+)
+
+// Fixture files. Every file can be YAML (.yaml) or JSON (.json).
+// The IPFS content is stored in the ipfs directory, e.g. ipfs/<cid>/info.yaml
+const (
+	FIXTURE_USERS   = "users"
+	FIXTURE_HISTORY = "history"
+	FIXTURE_CLUBS   = "clubs"
+	FIXTURE_POSTS   = "posts"
+	FIXTURE_IPFS    = "ipfs"
+)
+
+type fixtureUser struct {
+	Address  string                 `yaml:"address"`
+	Name     string                 `yaml:"name"`
+	Avatar   string                 `yaml:"avatar"` // IPFS path
+	Staked   string                 `yaml:"staked"`
+	Profiles map[string]UserProfile `yaml:"profiles"` // domain -> profile
+}
+
+type fixtureHistory struct {
+	Contract  string    `yaml:"contract"`
+	Domain    string    `yaml:"domain"`
+	Type      string    `yaml:"type"`
+	From      string    `yaml:"from"`
+	To        string    `yaml:"to"`
+	Amount    string    `yaml:"amount"`
+	Token     string    `yaml:"token"`
+	SavvaCid  string    `yaml:"savva_cid"`
+	Locales   string    `yaml:"locales"`
+	Info      string    `yaml:"info"`
+	TxHash    string    `yaml:"tx_hash"`
+	TimeStamp time.Time `yaml:"time_stamp"`
+}
+
+type fixtureClub struct {
+	Domain       string `yaml:"domain"`
+	Author       string `yaml:"author"`
+	CurrentFrame int    `yaml:"current_frame"`
+}
+
+type fixtureMember struct {
+	Domain   string `yaml:"domain"`
+	Author   string `yaml:"author"`
+	Member   string `yaml:"member"`
+	Amount   string `yaml:"amount"`
+	TilFrame int    `yaml:"til_frame"`
+}
+
+type fixtureClubs struct {
+	Clubs   []fixtureClub   `yaml:"clubs"`
+	Members []fixtureMember `yaml:"members"`
+}
+
+type fixturePost struct {
+	SavvaCid      string    `yaml:"savva_cid"`
+	ShortCid      string    `yaml:"short_cid"`
+	Author        string    `yaml:"author"`
+	Poster        string    `yaml:"poster"`
+	Domain        string    `yaml:"domain"`
+	Guid          string    `yaml:"guid"`
+	Ipfs          string    `yaml:"ipfs"`
+	TimeStamp     time.Time `yaml:"time_stamp"`
+	EffectiveTime time.Time `yaml:"effective_time"`
+	TotalChilds   int       `yaml:"total_childs"`
+}
+
+// Fixtures loads the report data from a directory of files, so the reports can be
+// rendered without the database and the IPFS gateway.
+type Fixtures struct {
+	cfg     *cmn.Config
+	users   []fixtureUser
+	history []fixtureHistory
+	clubs   fixtureClubs
+	posts   []fixturePost
+}
+
+func NewFixtures(cfg *cmn.Config) (*Fixtures, error) {
+	f := &Fixtures{cfg: cfg}
+
+	files := []struct {
+		name     string
+		v        any
+		optional bool
+	}{
+		{FIXTURE_USERS, &f.users, false},
+		{FIXTURE_HISTORY, &f.history, true},
+		{FIXTURE_CLUBS, &f.clubs, true},
+		{FIXTURE_POSTS, &f.posts, true},
+	}
+
+	for _, file := range files {
+		err := loadFixture(cfg.FixturesDir, file.name, file.v)
+		if err != nil {
+			if file.optional && errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+	}
+
+	return f, nil
+}
+
+// loadFixture reads name.yaml or name.json from the directory
+func loadFixture(dir, name string, v any) error {
+	for _, ext := range []string{".yaml", ".yml", ".json"} {
+		path := filepath.Join(dir, name+ext)
+		content, err := os.ReadFile(path)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return err
+		}
+
+		// JSON is a subset of YAML
+		if err := yaml.Unmarshal(content, v); err != nil {
+			return fmt.Errorf("failed to parse fixture %s: %w", path, err)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("fixture %s not found in %s: %w", name, dir, os.ErrNotExist)
+}
+
+// FixtureIPFS returns the function that loads the IPFS content from the fixture directory
+func FixtureIPFS(dir string) func(cid string) []byte {
+	root := filepath.Join(dir, FIXTURE_IPFS)
+	return func(cid string) []byte {
+		path := filepath.Join(root, filepath.FromSlash(filepath.Clean("/"+cid)))
+		content, err := os.ReadFile(path)
+		if err != nil {
+			log.Error().Err(err).Msgf("Error loading IPFS fixture %s", cid)
+			return nil
+		}
+		return content
+	}
+}
+
+func sameAddress(a, b string) bool {
+	return strings.EqualFold(a, b)
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func parseAmount(s string) *big.Int {
+	amount := new(big.Int)
+	if s != "" {
+		if _, ok := amount.SetString(s, 10); !ok {
+			log.Error().Msgf("Invalid amount in fixture: %s", s)
+		}
+	}
+	return amount
+}
+
+func (f *Fixtures) GetHistory(address string, from, to *time.Time) ([]HistoryRecord, error) {
+	var history []HistoryRecord
+
+	for _, h := range f.history {
+		if !sameAddress(h.From, address) && !sameAddress(h.To, address) {
+			continue
+		}
+
+		if from != nil && h.TimeStamp.Before(*from) {
+			continue
+		}
+
+		if to != nil && !h.TimeStamp.Before(*to) {
+			continue
+		}
+
+		history = append(history, HistoryRecord{
+			Contract:  nullString(h.Contract),
+			Domain:    nullString(h.Domain),
+			Type:      nullString(h.Type),
+			FromAddr:  nullString(h.From),
+			ToAddr:    nullString(h.To),
+			Amount:    parseAmount(h.Amount),
+			Token:     nullString(h.Token),
+			SavvaCid:  nullString(h.SavvaCid),
+			Locales:   nullString(h.Locales),
+			Info:      nullString(h.Info),
+			TxHash:    nullString(h.TxHash),
+			TimeStamp: h.TimeStamp,
+		})
+	}
+
+	slices.SortStableFunc(history, func(a, b HistoryRecord) int {
+		return b.TimeStamp.Compare(a.TimeStamp)
+	})
+
+	return history, nil
+}
+
+func (f *Fixtures) GetActiveAddresses(from, to time.Time) ([]string, error) {
+	var addresses []string
+
+	add := func(addr string) {
+		if addr == "" || addr == ZERO_ADDRESS {
+			return
+		}
+		if slices.ContainsFunc(addresses, func(a string) bool { return sameAddress(a, addr) }) {
+			return
+		}
+		addresses = append(addresses, addr)
+	}
+
+	for _, h := range f.history {
+		if !h.TimeStamp.Before(from) && h.TimeStamp.Before(to) {
+			add(h.From)
+			add(h.To)
+		}
+	}
+
+	for _, m := range f.clubs.Members {
+		if parseAmount(m.Amount).Sign() <= 0 {
+			continue
+		}
+
+		joined := slices.ContainsFunc(f.history, func(h fixtureHistory) bool {
+			return h.Contract == "club" && h.Type == "buy" && h.Domain == m.Domain &&
+				sameAddress(h.From, m.Member) && sameAddress(h.To, m.Author) && h.TimeStamp.Before(to)
+		})
+		if joined {
+			add(m.Member)
+		}
+	}
+
+	return addresses, nil
+}
+
+func (f *Fixtures) GetPostsByAuthor(address, sponsor string, from, to time.Time) ([]Post, error) {
+	r := make([]Post, 0)
+
+	for _, fp := range f.posts {
+		if !sameAddress(fp.Author, address) || fp.EffectiveTime.Before(from) || !fp.EffectiveTime.Before(to) {
+			continue
+		}
+
+		member := slices.ContainsFunc(f.clubs.Members, func(m fixtureMember) bool {
+			return m.Domain == fp.Domain && sameAddress(m.Author, fp.Author) && sameAddress(m.Member, sponsor)
+		})
+		if !member {
+			continue
+		}
+
+		p := Post{
+			SavvaCid:      fp.SavvaCid,
+			ShortCid:      nullString(fp.ShortCid),
+			AuthorAddr:    fp.Author,
+			PosterAddr:    nullString(fp.Poster),
+			Domain:        fp.Domain,
+			Guid:          fp.Guid,
+			Ipfs:          fp.Ipfs,
+			TimeStamp:     fp.TimeStamp,
+			EffectiveTime: fp.EffectiveTime,
+			TotalChilds:   fp.TotalChilds,
+			cfg:           f.cfg,
+		}
+
+		if err := p.LoadInfo(); err != nil {
+			log.Error().Err(err).Msgf("Failed to load post %s", p.SavvaCid)
+			continue
+		}
+
+		if err := p.loadThumbnail(f); err != nil {
+			log.Error().Err(err).Msgf("Failed to load thumbnail for post %s", p.SavvaCid)
+			continue
+		}
+
+		r = append(r, p)
+	}
+
+	return r, nil
+}
+
+func (f *Fixtures) GetSponsoredBy(address string) ([]Sponsored, error) {
+	var results []Sponsored
+
+	for _, m := range f.clubs.Members {
+		if !sameAddress(m.Member, address) {
+			continue
+		}
+
+		i := slices.IndexFunc(results, func(s Sponsored) bool { return sameAddress(s.Author, m.Author) })
+		if i < 0 {
+			total := big.NewInt(0)
+			for _, o := range f.clubs.Members {
+				if sameAddress(o.Author, m.Author) {
+					total.Add(total, parseAmount(o.Amount))
+				}
+			}
+
+			results = append(results, Sponsored{
+				Author:       m.Author,
+				Member:       address,
+				TotalAmount:  big.NewInt(0),
+				TotalFromAll: total,
+				Domains:      []DomainRecord{},
+			})
+			i = len(results) - 1
+		}
+
+		currentFrame := 0
+		for _, c := range f.clubs.Clubs {
+			if c.Domain == m.Domain && sameAddress(c.Author, m.Author) {
+				currentFrame = c.CurrentFrame
+			}
+		}
+
+		amt := parseAmount(m.Amount)
+		s := &results[i]
+		s.TotalAmount.Add(s.TotalAmount, amt)
+		s.Domain = m.Domain
+		s.Domains = append(s.Domains, DomainRecord{
+			Domain:       m.Domain,
+			Amount:       amt,
+			CurrentFrame: currentFrame,
+			TilFrame:     m.TilFrame,
+		})
+	}
+
+	slices.SortFunc(results, func(a, b Sponsored) int {
+		return b.TotalAmount.Cmp(a.TotalAmount)
+	})
+
+	return results, nil
+}
+
+func (f *Fixtures) GetUser(address string) (*User, error) {
+	user := User{
+		Address:    address,
+		Staked:     *big.NewInt(0),
+		Profiles:   make(map[string]UserProfile),
+		AvatarData: assets.AvatarDefault,
+		AvatarImg:  assets.AvatarDefaultImg,
+	}
+
+	i := slices.IndexFunc(f.users, func(u fixtureUser) bool { return sameAddress(u.Address, address) })
+	if i < 0 {
+		return &user, nil
+	}
+
+	fu := f.users[i]
+	user.Name = fu.Name
+	user.AvatarCid = fu.Avatar
+	user.Staked = *parseAmount(fu.Staked)
+	if fu.Profiles != nil {
+		user.Profiles = fu.Profiles
+	}
+
+	if user.AvatarCid != "" {
+		img, err := f.cfg.LoadImage(user.AvatarCid)
+		if err != nil {
+			log.Error().Err(err).Msgf("Failed to load avatar of %s", address)
+		} else {
+			user.AvatarImg = img
+		}
+	}
+
+	return &user, nil
+}
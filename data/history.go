@@ -5,10 +5,10 @@ import (
 	"log"
 	"math/big"
 	"time"
-
-	"github.com/AlexNa-Holdings/savva-reports/cmn"
 )
 
+const ZERO_ADDRESS = "0x0000000000000000000000000000000000000000"
+
 type HistoryRecord struct {
 	Contract  sql.NullString
 	Domain    sql.NullString
@@ -24,12 +24,13 @@ type HistoryRecord struct {
 	TimeStamp time.Time
 }
 
-func GetHistory(address string, from, to *time.Time) ([]HistoryRecord, error) {
-	rows, err := cmn.C.DB.Query(`SELECT contract, domain, type, from_addr, to_addr, amount, token, savva_cid, locales, info, tx_hash, time_stamp 
+func (s *Postgres) GetHistory(address string, from, to *time.Time) ([]HistoryRecord, error) {
+	rows, err := s.cfg.DB.Query(`SELECT contract, domain, type, from_addr, to_addr, amount, token, savva_cid, locales, info, tx_hash, time_stamp 
 	FROM history 
 	WHERE 
 	( from_addr = $1 OR to_addr = $1 )
-	 AND time_stamp BETWEEN $2 AND $3 ORDER BY time_stamp DESC`, address, from, to)
+	 AND ( $2::timestamptz IS NULL OR time_stamp >= $2 )
+	 AND ( $3::timestamptz IS NULL OR time_stamp < $3 ) ORDER BY time_stamp DESC`, address, from, to)
 	if err != nil {
 		log.Printf("Error querying history for %s: %v", address, err)
 		return nil, err
@@ -62,3 +63,39 @@ func GetHistory(address string, from, to *time.Time) ([]HistoryRecord, error) {
 
 	return history, nil
 }
+
+// GetActiveAddresses returns the addresses that have history records in the period
+// or are members of any authors club since before its end. The memberships have no
+// dates, a current one counts from the first purchase of the club.
+func (s *Postgres) GetActiveAddresses(from, to time.Time) ([]string, error) {
+	rows, err := s.cfg.DB.Query(`
+		SELECT from_addr FROM history WHERE time_stamp >= $1 AND time_stamp < $2
+		UNION
+		SELECT to_addr FROM history WHERE time_stamp >= $1 AND time_stamp < $2
+		UNION
+		SELECT cm.member_addr FROM clubs_members cm WHERE cm.amount > 0
+		AND EXISTS ( SELECT 1 FROM history h WHERE h.contract = 'club' AND h.type = 'buy'
+			AND h.domain = cm.domain AND h.from_addr = cm.member_addr AND h.to_addr = cm.author_addr AND h.time_stamp < $2 )
+	`, from, to)
+	if err != nil {
+		log.Printf("Error querying active addresses: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var addresses []string
+	for rows.Next() {
+		var addr sql.NullString
+		if err := rows.Scan(&addr); err != nil {
+			return nil, err
+		}
+
+		if !addr.Valid || addr.String == "" || addr.String == ZERO_ADDRESS {
+			continue
+		}
+
+		addresses = append(addresses, addr.String)
+	}
+
+	return addresses, rows.Err()
+}
@@ -69,37 +69,39 @@ type Post struct {
 	TotalChilds   int
 	c_v2_0        SavvaContent_v2_0
 	ThumbnailImg  image.Image
+
+	cfg *cmn.Config // to load the content from IPFS
 }
 
-func GetPostsByAuthor(address, sponsor string, from, to time.Time) ([]Post, error) {
+func (s *Postgres) GetPostsByAuthor(address, sponsor string, from, to time.Time) ([]Post, error) {
 
 	r := make([]Post, 0)
 
-	rows, err := cmn.C.DB.Query(`
+	rows, err := s.cfg.DB.Query(`
 		SELECT savva_cid, short_cid, author_addr, poster_addr, domain, guid, ipfs, time_stamp, effective_time, total_childs
 		FROM savva_content
 		WHERE
 		savva_content.content_type = 'post' 
 		AND EXISTS ( SELECT 1 FROM clubs_members cm WHERE cm.domain = savva_content.domain AND cm.author_addr = savva_content.author_addr AND cm.member_addr = $2 )
-		AND author_addr = $1 AND effective_time BETWEEN $3 AND $4
+		AND author_addr = $1 AND effective_time >= $3 AND effective_time < $4
 	`, address, sponsor, from, to)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 	for rows.Next() {
-		var p Post
+		p := Post{cfg: s.cfg}
 		if err := rows.Scan(&p.SavvaCid, &p.ShortCid, &p.AuthorAddr, &p.PosterAddr, &p.Domain, &p.Guid, &p.Ipfs, &p.TimeStamp, &p.EffectiveTime, &p.TotalChilds); err != nil {
 			return nil, err
 		}
 
-		err := LoadPostInfo(&p)
+		err := p.LoadInfo()
 		if err != nil {
 			log.Error().Err(err).Msgf("Failed to load post %s", p.SavvaCid)
 			continue
 		}
 
-		err = p.LoadThumbnail()
+		err = p.loadThumbnail(s)
 		if err != nil {
 			log.Error().Err(err).Msgf("Failed to load thumbnail for post %s", p.SavvaCid)
 			continue
@@ -113,8 +115,8 @@ func GetPostsByAuthor(address, sponsor string, from, to time.Time) ([]Post, erro
 	return r, nil
 }
 
-func LoadPostInfo(post *Post) error {
-	fileContent := cmn.C.IPFS(post.Ipfs + "/info.yaml")
+func (post *Post) LoadInfo() error {
+	fileContent := post.cfg.IPFS(post.Ipfs + "/info.yaml")
 	if fileContent == nil {
 		return fmt.Errorf("failed to load post %s", post.SavvaCid)
 	}
@@ -149,9 +151,10 @@ func LoadPostInfo(post *Post) error {
 	}
 }
 
-func (p *Post) LoadThumbnail() error {
+// loadThumbnail loads the thumbnail of the post or uses the author's avatar if there is none
+func (p *Post) loadThumbnail(src Source) error {
 	if p.c_v2_0.Thumbnail == "" {
-		user, err := GetUser(p.AuthorAddr)
+		user, err := src.GetUser(p.AuthorAddr)
 		if err != nil {
 			log.Error().Msgf("Failed to load user %s", p.AuthorAddr)
 			return fmt.Errorf("failed to load user %s", p.AuthorAddr)
@@ -167,7 +170,7 @@ func (p *Post) LoadThumbnail() error {
 	}
 
 	var err error
-	p.ThumbnailImg, err = cmn.LoadImage(p.Ipfs + dp)
+	p.ThumbnailImg, err = p.cfg.LoadImage(p.Ipfs + dp)
 	if err != nil {
 		log.Error().Msgf("Failed to decode thumbnail for post %s", p.SavvaCid)
 		return fmt.Errorf("failed to decode thumbnail for post %s", p.SavvaCid)
@@ -222,7 +225,7 @@ func (p *Post) GetContent(locale string) (string, error) {
 					dp = "/" + dp
 				}
 
-				content := cmn.C.IPFS(p.Ipfs + dp)
+				content := p.cfg.IPFS(p.Ipfs + dp)
 				if content == nil {
 					return "", fmt.Errorf("failed to load content for post %s", p.SavvaCid)
 				}
@@ -239,5 +242,5 @@ func (p *Post) GetImage(url string) (image.Image, error) {
 		url = "/" + url
 	}
 
-	return cmn.LoadImage(p.Ipfs + url)
+	return p.cfg.LoadImage(p.Ipfs + url)
 }
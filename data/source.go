@@ -0,0 +1,38 @@
+package data
+
+import (
+	"time"
+
+	"github.com/AlexNa-Holdings/savva-reports/cmn"
+)
+
+// Source provides the report data
+type Source interface {
+	GetHistory(address string, from, to *time.Time) ([]HistoryRecord, error) // to is exclusive, nil from or to leaves the period open
+	GetActiveAddresses(from, to time.Time) ([]string, error)
+	GetPostsByAuthor(address, sponsor string, from, to time.Time) ([]Post, error)
+	GetSponsoredBy(address string) ([]Sponsored, error)
+	GetUser(address string) (*User, error)
+}
+
+// NewSource returns the fixture source if cfg.FixturesDir is set, the Postgres one otherwise.
+// Every report uses its own source, so the reports rendered in parallel do not share the caches.
+func NewSource(cfg *cmn.Config) (Source, error) {
+	if cfg.FixturesDir != "" {
+		return NewFixtures(cfg)
+	}
+	return NewPostgres(cfg), nil
+}
+
+// Postgres loads the report data from the database and IPFS
+type Postgres struct {
+	cfg       *cmn.Config
+	userCache *cmn.Cache[string, *User]
+}
+
+func NewPostgres(cfg *cmn.Config) *Postgres {
+	return &Postgres{
+		cfg:       cfg,
+		userCache: cmn.NewCache[string, *User](100),
+	}
+}
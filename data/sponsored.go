@@ -4,8 +4,6 @@ import (
 	"database/sql"
 	"math/big"
 	"slices"
-
-	"github.com/AlexNa-Holdings/savva-reports/cmn"
 )
 
 type DomainRecord struct {
@@ -40,8 +38,8 @@ type Sponsored struct {
 // 	CONSTRAINT clubs_members_pkey PRIMARY KEY (domain, author_addr, member_addr)
 // );
 
-func GetSponsoredBy(address string) ([]Sponsored, error) {
-	rows, err := cmn.C.DB.Query(`
+func (s *Postgres) GetSponsoredBy(address string) ([]Sponsored, error) {
+	rows, err := s.cfg.DB.Query(`
 SELECT 
 	cm.domain, 
 	cm.author_addr, 
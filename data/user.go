@@ -9,14 +9,13 @@ import (
 	"strings"
 
 	"github.com/AlexNa-Holdings/savva-reports/assets"
-	"github.com/AlexNa-Holdings/savva-reports/cmn"
 )
 
 type UserProfile struct {
-	About         string `json:"about"`
-	DisplayName   string `json:"display_name"`
-	SponsorValues []int  `json:"sponsor_values"`
-	Name          string `json:"name"`
+	About         string `json:"about" yaml:"about"`
+	DisplayName   string `json:"display_name" yaml:"display_name"`
+	SponsorValues []int  `json:"sponsor_values" yaml:"sponsor_values"`
+	Name          string `json:"name" yaml:"name"`
 }
 
 type User struct {
@@ -29,11 +28,9 @@ type User struct {
 	Profiles   map[string]UserProfile // domaion -> profile
 }
 
-var UserCache = cmn.NewCache[string, *User](100)
-
-func GetUser(address string) (*User, error) {
+func (s *Postgres) GetUser(address string) (*User, error) {
 	// Check if the user is already cached
-	if o, found := UserCache.Get(address); found {
+	if o, found := s.userCache.Get(address); found {
 		return o, nil
 	}
 
@@ -48,7 +45,7 @@ func GetUser(address string) (*User, error) {
 	var n_avatar sql.NullString
 	var n_name sql.NullString
 
-	err := cmn.C.DB.QueryRow(`SELECT name,avatar,staked FROM users WHERE user_addr = $1`, address).Scan(
+	err := s.cfg.DB.QueryRow(`SELECT name,avatar,staked FROM users WHERE user_addr = $1`, address).Scan(
 		&n_name, &n_avatar, &n_staked)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -66,14 +63,14 @@ func GetUser(address string) (*User, error) {
 
 	// load the avatar from IPFS
 	if user.AvatarCid != "" {
-		user.AvatarImg, err = cmn.LoadImage(user.AvatarCid)
+		user.AvatarImg, err = s.cfg.LoadImage(user.AvatarCid)
 	}
 
 	if user.AvatarImg == nil {
 		user.AvatarImg = assets.AvatarDefaultImg
 	}
 
-	rows, err := cmn.C.DB.Query(`SELECT domain, value FROM user_params WHERE user_addr = $1 AND key = 'profile_cid'`, address)
+	rows, err := s.cfg.DB.Query(`SELECT domain, value FROM user_params WHERE user_addr = $1 AND key = 'profile_cid'`, address)
 	if err != nil {
 		log.Printf("Error querying user %s: %v", address, err)
 		return nil, err
@@ -90,7 +87,7 @@ func GetUser(address string) (*User, error) {
 		}
 
 		// load the profile from IPFS
-		data := cmn.C.IPFS(value)
+		data := s.cfg.IPFS(value)
 		if data == nil {
 			log.Printf("Error loading IPFS file for user %s: %s", address, value)
 			return nil, err
@@ -105,6 +102,7 @@ func GetUser(address string) (*User, error) {
 		user.Profiles[domain] = profile
 	}
 
+	s.userCache.Set(address, &user)
 	return &user, nil
 }
 
@@ -0,0 +1,98 @@
+package i18n
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Usage is the result of the check of one language
+type Usage struct {
+	Missing []string // used but not translated
+	Unused  []string // translated but not used
+}
+
+// ScanKeys returns the string literals passed to the T and Tf functions and methods in the
+// Go files of the directories, with the positions of the first use
+func ScanKeys(dirs ...string) (map[string]string, error) {
+	keys := make(map[string]string)
+	fset := token.NewFileSet()
+
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, e := range entries {
+			if e.IsDir() || !strings.HasSuffix(e.Name(), ".go") || strings.HasSuffix(e.Name(), "_test.go") {
+				continue
+			}
+
+			file, err := parser.ParseFile(fset, filepath.Join(dir, e.Name()), nil, parser.SkipObjectResolution)
+			if err != nil {
+				return nil, err
+			}
+
+			ast.Inspect(file, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok || len(call.Args) == 0 || !isT(call.Fun) {
+					return true
+				}
+
+				lit, ok := call.Args[0].(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					return true
+				}
+
+				if key, err := strconv.Unquote(lit.Value); err == nil {
+					if _, ok := keys[key]; !ok {
+						keys[key] = fset.Position(lit.Pos()).String()
+					}
+				}
+				return true
+			})
+		}
+	}
+
+	return keys, nil
+}
+
+func isT(fun ast.Expr) bool {
+	switch f := fun.(type) {
+	case *ast.Ident:
+		return f.Name == "T" || f.Name == "Tf"
+	case *ast.SelectorExpr:
+		return f.Sel.Name == "T" || f.Sel.Name == "Tf"
+	}
+	return false
+}
+
+// Check compares the used keys with the translations of every language
+func (c *Catalog) Check(used []string) map[string]Usage {
+	r := make(map[string]Usage, len(c.Languages))
+	for code, l := range c.Languages {
+		var u Usage
+		for _, key := range used {
+			if _, ok := l.Dictionary[key]; !ok {
+				u.Missing = append(u.Missing, key)
+			}
+		}
+
+		for _, key := range slices.Sorted(maps.Keys(l.Dictionary)) {
+			if !slices.Contains(used, key) {
+				u.Unused = append(u.Unused, key)
+			}
+		}
+
+		slices.Sort(u.Missing)
+		r[code] = u
+	}
+	return r
+}
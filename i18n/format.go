@@ -0,0 +1,226 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// NBSP separates the groups of digits and the units where the line must not break
+const NBSP = "\u00a0"
+
+// Format describes how the dates and the numbers are written in the language.
+//
+// The date patterns use the CLDR letters: y (year), M and MM (month number), MMM
+// (short month), MMMM (month in a date, genitive in Russian), LLLL (month alone),
+// d and dd (day), H and HH (hour), mm (minute), ss (second) and z (zone
+// abbreviation or offset). Text in single quotes is copied as is.
+type Format struct {
+	DatePattern      string `yaml:"date" json:"date"`             // e.g. March 2, 2025
+	ShortDatePattern string `yaml:"short_date" json:"short_date"` // for the tables
+	TimePattern      string `yaml:"time" json:"time"`             // time of the day
+	DateTimePattern  string `yaml:"date_time" json:"date_time"`   // with the zone
+
+	MonthsInDate []string `yaml:"months_in_date" json:"months_in_date"` // MMMM
+	ShortMonths  []string `yaml:"short_months" json:"short_months"`     // MMM
+
+	Group   string `yaml:"group" json:"group"` // separator of the groups of three digits
+	Decimal string `yaml:"decimal" json:"decimal"`
+
+	PercentPattern  string `yaml:"percent" json:"percent"`   // # is the number
+	CurrencyPattern string `yaml:"currency" json:"currency"` // # is the number, ¤ is the symbol
+}
+
+// merge overrides the fields set in o
+func (f *Format) merge(o *Format) {
+	set := func(dst *string, src string) {
+		if src != "" {
+			*dst = src
+		}
+	}
+
+	set(&f.DatePattern, o.DatePattern)
+	set(&f.ShortDatePattern, o.ShortDatePattern)
+	set(&f.TimePattern, o.TimePattern)
+	set(&f.DateTimePattern, o.DateTimePattern)
+	set(&f.Group, o.Group)
+	set(&f.Decimal, o.Decimal)
+	set(&f.PercentPattern, o.PercentPattern)
+	set(&f.CurrencyPattern, o.CurrencyPattern)
+
+	if len(o.MonthsInDate) > 0 {
+		f.MonthsInDate = o.MonthsInDate
+	}
+	if len(o.ShortMonths) > 0 {
+		f.ShortMonths = o.ShortMonths
+	}
+}
+
+func (f *Format) validate() []error {
+	var errs []error
+
+	if f.DatePattern == "" || f.ShortDatePattern == "" || f.TimePattern == "" || f.DateTimePattern == "" {
+		errs = append(errs, errors.New("format: the date and time patterns are not set"))
+	}
+	if len(f.MonthsInDate) != 12 || len(f.ShortMonths) != 12 {
+		errs = append(errs, errors.New("format: months_in_date and short_months must have 12 names"))
+	}
+	if f.Decimal == "" {
+		errs = append(errs, errors.New("format: decimal is not set"))
+	}
+	if !strings.Contains(f.PercentPattern, "#") {
+		errs = append(errs, errors.New("format: percent must contain #"))
+	}
+	if !strings.Contains(f.CurrencyPattern, "#") || !strings.Contains(f.CurrencyPattern, "¤") {
+		errs = append(errs, errors.New("format: currency must contain # and ¤"))
+	}
+
+	return errs
+}
+
+// FormatPattern formats the time with the date pattern
+func (l *Language) FormatPattern(t time.Time, pattern string) string {
+	var b strings.Builder
+
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); {
+		c := runes[i]
+
+		if c == '\'' {
+			j := i + 1
+			for j < len(runes) && runes[j] != '\'' {
+				j++
+			}
+			b.WriteString(string(runes[i+1 : min(j, len(runes))]))
+			i = j + 1
+			continue
+		}
+
+		n := 1
+		for i+n < len(runes) && runes[i+n] == c {
+			n++
+		}
+		i += n
+
+		switch c {
+		case 'y':
+			if n == 2 {
+				fmt.Fprintf(&b, "%02d", t.Year()%100)
+			} else {
+				fmt.Fprintf(&b, "%d", t.Year())
+			}
+		case 'M', 'L':
+			month := int(t.Month()) - 1
+			switch {
+			case n <= 2:
+				fmt.Fprintf(&b, "%0*d", n, month+1)
+			case n == 3:
+				b.WriteString(l.Format.ShortMonths[month])
+			case c == 'M':
+				b.WriteString(l.Format.MonthsInDate[month])
+			default:
+				b.WriteString(l.Months[month])
+			}
+		case 'd':
+			fmt.Fprintf(&b, "%0*d", n, t.Day())
+		case 'H':
+			fmt.Fprintf(&b, "%0*d", n, t.Hour())
+		case 'm':
+			fmt.Fprintf(&b, "%02d", t.Minute())
+		case 's':
+			fmt.Fprintf(&b, "%02d", t.Second())
+		case 'z':
+			b.WriteString(t.Format("MST"))
+		default:
+			b.WriteString(strings.Repeat(string(c), n))
+		}
+	}
+
+	return b.String()
+}
+
+func (l *Language) FormatDate(t time.Time) string {
+	return l.FormatPattern(t, l.Format.DatePattern)
+}
+
+func (l *Language) FormatShortDate(t time.Time) string {
+	return l.FormatPattern(t, l.Format.ShortDatePattern)
+}
+
+func (l *Language) FormatTimeOfDay(t time.Time) string {
+	return l.FormatPattern(t, l.Format.TimePattern)
+}
+
+func (l *Language) FormatDateTime(t time.Time) string {
+	return l.FormatPattern(t, l.Format.DateTimePattern)
+}
+
+// FormatNumber writes the decimal number, e.g. -1234.5, with the separators of the language
+func (l *Language) FormatNumber(number string) string {
+	sign := ""
+	if strings.HasPrefix(number, "-") {
+		sign, number = "-", number[1:]
+	}
+
+	int_part, frac_part, _ := strings.Cut(number, ".")
+
+	// a negative value rounded to zero, e.g. -0.001 with 2 decimals, has no sign
+	if strings.Trim(int_part+frac_part, "0") == "" {
+		sign = ""
+	}
+
+	var b strings.Builder
+	b.WriteString(sign)
+	for i, ch := range int_part {
+		if i > 0 && (len(int_part)-i)%3 == 0 {
+			b.WriteString(l.Format.Group)
+		}
+		b.WriteRune(ch)
+	}
+
+	if frac_part != "" {
+		b.WriteString(l.Format.Decimal)
+		b.WriteString(frac_part)
+	}
+	return b.String()
+}
+
+// FormatPercent writes the percentage, 25 is 25%
+func (l *Language) FormatPercent(value float64, decimals int) string {
+	return strings.Replace(l.Format.PercentPattern, "#", l.FormatNumber(fmt.Sprintf("%.*f", decimals, value)), 1)
+}
+
+// FormatCurrency writes the amount with the currency symbol. The symbols ending with
+// a letter, like CHF, are separated from the number.
+func (l *Language) FormatCurrency(value float64, decimals int, symbol string) string {
+	number := l.FormatNumber(fmt.Sprintf("%.*f", decimals, value))
+
+	sign := ""
+	if strings.HasPrefix(number, "-") {
+		sign, number = "-", number[1:]
+	}
+
+	pattern := l.Format.CurrencyPattern
+	if strings.Contains(pattern, "¤#") && endsWithLetter(symbol) {
+		pattern = strings.Replace(pattern, "¤#", "¤"+NBSP+"#", 1)
+	}
+	if strings.Contains(pattern, "#¤") && startsWithLetter(symbol) {
+		pattern = strings.Replace(pattern, "#¤", "#"+NBSP+"¤", 1)
+	}
+
+	return sign + strings.NewReplacer("#", number, "¤", symbol).Replace(pattern)
+}
+
+func startsWithLetter(s string) bool {
+	for _, r := range s {
+		return unicode.IsLetter(r)
+	}
+	return false
+}
+
+func endsWithLetter(s string) bool {
+	r := []rune(s)
+	return len(r) > 0 && unicode.IsLetter(r[len(r)-1])
+}
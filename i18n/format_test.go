@@ -0,0 +1,133 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatNumber(t *testing.T) {
+	tests := []struct {
+		lang   string
+		number string
+		want   string
+	}{
+		{"en", "0", "0"},
+		{"en", "123", "123"},
+		{"en", "1234", "1,234"},
+		{"en", "1234567.891", "1,234,567.891"},
+		{"en", "-1234.5", "-1,234.5"},
+		{"en", "-0.00", "0.00"},
+		{"en", "-0", "0"},
+		{"en", "-0.01", "-0.01"},
+		{"ru", "1234567.5", "1\u00a0234\u00a0567,5"},
+		{"uk", "-1234.5", "-1\u00a0234,5"},
+		{"de", "1234567.5", "1.234.567,5"},
+		{"es", "1234.5", "1.234,5"},
+		{"ar", "1234567.5", "1,234,567.5"},
+		{"zh", "1234567.5", "1,234,567.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang+" "+tt.number, func(t *testing.T) {
+			if got := Default().Get(tt.lang).FormatNumber(tt.number); got != tt.want {
+				t.Errorf("FormatNumber(%q) = %q, want %q", tt.number, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatPercent(t *testing.T) {
+	tests := []struct {
+		lang     string
+		value    float64
+		decimals int
+		want     string
+	}{
+		{"en", 25, 0, "25%"},
+		{"en", 12.345, 2, "12.35%"},
+		{"en", -0.001, 2, "0.00%"},
+		{"en", -1.5, 1, "-1.5%"},
+		{"ru", 12.5, 1, "12,5\u00a0%"},
+		{"uk", 12.5, 1, "12,5%"},
+		{"de", 1234.5, 1, "1.234,5\u00a0%"},
+		{"es", 0.5, 2, "0,50\u00a0%"},
+		{"ar", 12.5, 1, "12.5%"},
+		{"zh", 12.5, 1, "12.5%"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang, func(t *testing.T) {
+			if got := Default().Get(tt.lang).FormatPercent(tt.value, tt.decimals); got != tt.want {
+				t.Errorf("FormatPercent(%v, %d) = %q, want %q", tt.value, tt.decimals, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatCurrency(t *testing.T) {
+	tests := []struct {
+		lang     string
+		value    float64
+		decimals int
+		symbol   string
+		want     string
+	}{
+		{"en", 1234.5, 2, "$", "$1,234.50"},
+		{"en", -1234.5, 2, "$", "-$1,234.50"},
+		{"en", -0.001, 2, "$", "$0.00"},
+		{"en", -0.004, 2, "$", "$0.00"},
+		{"en", -0.005, 2, "$", "-$0.01"},
+		{"en", 12, 2, "CHF", "CHF\u00a012.00"},
+		{"en", 12, 0, "US$", "US$12"},
+		{"ru", 1234.5, 2, "₽", "1\u00a0234,50\u00a0₽"},
+		{"ru", -1234.5, 2, "€", "-1\u00a0234,50\u00a0€"},
+		{"ru", -0.001, 2, "€", "0,00\u00a0€"},
+		{"uk", 1234.5, 2, "₴", "1\u00a0234,50\u00a0₴"},
+		{"de", 1234.5, 2, "€", "1.234,50\u00a0€"},
+		{"de", 12, 2, "CHF", "12,00\u00a0CHF"},
+		{"es", 1234567, 0, "€", "1.234.567\u00a0€"},
+		{"ar", 1234.5, 2, "$", "1,234.50\u00a0$"},
+		{"zh", 1234.5, 2, "¥", "¥1,234.50"},
+		{"zh", -1234.5, 2, "¥", "-¥1,234.50"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang+" "+tt.symbol, func(t *testing.T) {
+			if got := Default().Get(tt.lang).FormatCurrency(tt.value, tt.decimals, tt.symbol); got != tt.want {
+				t.Errorf("FormatCurrency(%v, %d, %q) = %q, want %q", tt.value, tt.decimals, tt.symbol, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatPattern(t *testing.T) {
+	tm := time.Date(2025, 3, 2, 9, 5, 7, 0, time.UTC)
+
+	tests := []struct {
+		lang    string
+		pattern string
+		want    string
+	}{
+		{"en", "MMMM d, y", "March 2, 2025"},
+		{"en", "y-MM-dd HH:mm:ss z", "2025-03-02 09:05:07 UTC"},
+		{"en", "yy M d H", "25 3 2 9"},
+		{"en", "MMM d", "Mar 2"},
+		{"en", "'at' HH:mm 'o''clock", "at 09:05 oclock"},
+		{"en", "d 'unclosed", "2 unclosed"},
+		{"ru", "d MMMM y 'г.'", "2 марта 2025 г."},
+		{"ru", "LLLL y", "Март 2025"},
+		{"ru", "d MMM", "2 мар."},
+		{"uk", "d MMMM y 'р.'", "2 березня 2025 р."},
+		{"de", "d. MMMM y", "2. März 2025"},
+		{"es", "d 'de' MMMM 'de' y", "2 de marzo de 2025"},
+		{"zh", "y年M月d日", "2025年3月2日"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang+" "+tt.pattern, func(t *testing.T) {
+			if got := Default().Get(tt.lang).FormatPattern(tm, tt.pattern); got != tt.want {
+				t.Errorf("FormatPattern(%q) = %q, want %q", tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,35 +1,226 @@
 package i18n
 
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+)
+
+// FALLBACK is the language of the keys missing in the other languages
+const FALLBACK = "en"
+
+// EXTENSIONS are the extensions of the translation files, the name of the file is the language code
+var EXTENSIONS = []string{".yaml", ".yml", ".json"}
+
+//go:embed locales
+var embedded embed.FS
+
+// text directions of the languages
+const (
+	DIRECTION_LTR = "ltr"
+	DIRECTION_RTL = "rtl"
+)
+
+// Language is the content of one translation file
 type Language struct {
-	Months     [12]string
-	Dictionary map[string]string
+	Months     []string          `yaml:"months" json:"months"`       // standalone, e.g. in the titles
+	Plural     string            `yaml:"plural" json:"plural"`       // name of the PluralRules
+	Direction  string            `yaml:"direction" json:"direction"` // DIRECTION_LTR or DIRECTION_RTL, LTR if empty
+	Format     Format            `yaml:"format" json:"format"`
+	Dictionary map[string]string `yaml:"dictionary" json:"dictionary"`
 }
 
-var Languages = map[string]Language{
-	"en": lang_en,
-	"ru": lang_ru,
+// Catalog holds the translations of all the report languages
+type Catalog struct {
+	Languages map[string]*Language
 }
 
-func getLang(lang string) Language {
-	language, ok := Languages[lang]
+// Default returns the built-in translations
+var Default = sync.OnceValue(func() *Catalog {
+	c := &Catalog{Languages: make(map[string]*Language)}
+
+	entries, err := embedded.ReadDir("locales")
+	if err != nil {
+		panic(fmt.Sprintf("invalid built-in translations: %v", err))
+	}
+
+	for _, e := range entries {
+		content, err := embedded.ReadFile(path.Join("locales", e.Name()))
+		if err == nil {
+			err = c.merge(e.Name(), content)
+		}
+		if err != nil {
+			panic(fmt.Sprintf("invalid built-in translations: %v", err))
+		}
+	}
+
+	if err := c.validate(); err != nil {
+		panic(fmt.Sprintf("invalid built-in translations: %v", err))
+	}
+	return c
+})
+
+// Load returns the built-in translations overridden by the files of the directory.
+// A file may contain only some keys of a built-in language, a new language must
+// have the months and the formats.
+func Load(dir string) (*Catalog, error) {
+	c := Default().clone()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range entries {
+		if e.IsDir() || !slices.Contains(EXTENSIONS, filepath.Ext(e.Name())) {
+			continue
+		}
+
+		content, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		if err := c.merge(e.Name(), content); err != nil {
+			return nil, fmt.Errorf("%s: %w", dir, err)
+		}
+	}
+
+	if err := c.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", dir, err)
+	}
+	return c, nil
+}
+
+func (c *Catalog) clone() *Catalog {
+	r := &Catalog{Languages: make(map[string]*Language, len(c.Languages))}
+	for code, l := range c.Languages {
+		lang := *l
+		lang.Dictionary = maps.Clone(l.Dictionary)
+		r.Languages[code] = &lang
+	}
+	return r
+}
+
+// merge adds the content of the translation file to the language of its name
+func (c *Catalog) merge(name string, content []byte) error {
+	code := strings.TrimSuffix(name, filepath.Ext(name))
+
+	var l Language
+	var err error
+	if filepath.Ext(name) == ".json" {
+		err = json.Unmarshal(content, &l)
+	} else {
+		err = yaml.UnmarshalStrict(content, &l)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to parse %s: %w", name, err)
+	}
+
+	base, ok := c.Languages[code]
 	if !ok {
-		language = Languages["en"]
+		base = &Language{Dictionary: make(map[string]string)}
+		c.Languages[code] = base
+	}
+
+	if len(l.Months) > 0 {
+		base.Months = l.Months
+	}
+	if l.Plural != "" {
+		base.Plural = l.Plural
+	}
+	if l.Direction != "" {
+		base.Direction = l.Direction
 	}
-	return language
+	base.Format.merge(&l.Format)
+	maps.Copy(base.Dictionary, l.Dictionary)
+	return nil
 }
 
-func GetMonthName(month int, lang string) string {
-	language := getLang(lang)
-	if month < 1 || month > 12 {
-		return ""
+func (c *Catalog) validate() error {
+	if _, ok := c.Languages[FALLBACK]; !ok {
+		return fmt.Errorf("no translations for the fallback language %s", FALLBACK)
+	}
+
+	var errs []error
+	for _, code := range c.Codes() {
+		l := c.Languages[code]
+		if len(l.Months) != 12 {
+			errs = append(errs, fmt.Errorf("%s: months must have 12 names", code))
+		}
+		if _, ok := PluralRules[l.Plural]; !ok {
+			errs = append(errs, fmt.Errorf("%s: unknown plural rule %q", code, l.Plural))
+		}
+		if l.Direction != "" && l.Direction != DIRECTION_LTR && l.Direction != DIRECTION_RTL {
+			errs = append(errs, fmt.Errorf("%s: unknown direction %q", code, l.Direction))
+		}
+		for _, err := range l.Format.validate() {
+			errs = append(errs, fmt.Errorf("%s: %w", code, err))
+		}
+		for _, key := range slices.Sorted(maps.Keys(l.Dictionary)) {
+			if _, err := parseMessage(l.Dictionary[key]); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %s: %w", code, key, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Has reports whether the language is supported
+func (c *Catalog) Has(lang string) bool {
+	_, ok := c.Languages[lang]
+	return ok
+}
+
+// Codes returns the supported languages
+func (c *Catalog) Codes() []string {
+	return slices.Sorted(maps.Keys(c.Languages))
+}
+
+// Get returns the language or the fallback one if it is not supported
+func (c *Catalog) Get(lang string) *Language {
+	if l, ok := c.Languages[lang]; ok {
+		return l
 	}
-	return language.Months[month-1]
+	return c.Languages[FALLBACK]
 }
 
-func T(key string, locale string) string {
-	language := getLang(locale)
-	if value, ok := language.Dictionary[key]; ok {
-		return value
+// Lookup returns the translation of the key. If the language has none, the
+// fallback translation is returned and ok is false.
+func (c *Catalog) Lookup(key, lang string) (value string, ok bool) {
+	if value, ok := c.Get(lang).Dictionary[key]; ok {
+		return value, true
+	}
+	if value, ok := c.Languages[FALLBACK].Dictionary[key]; ok {
+		return value, false
+	}
+	return "[" + key + "]", false
+}
+
+func (c *Catalog) T(key, lang string) string {
+	value, _ := c.Lookup(key, lang)
+	return value
+}
+
+// RTL reports whether the language is written from right to left
+func (l *Language) RTL() bool {
+	return l.Direction == DIRECTION_RTL
+}
+
+// MonthName returns the standalone name of the month 1-12
+func (l *Language) MonthName(month int) string {
+	if month < 1 || month > len(l.Months) {
+		return ""
 	}
-	return "[" + key + "]"
+	return l.Months[month-1]
 }
@@ -0,0 +1,281 @@
+package i18n
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Args are the named parameters of a message
+type Args map[string]any
+
+// A message is the text with the parameters in the ICU MessageFormat syntax:
+//
+//	{name}                    the parameter written with fmt.Sprint, so the numbers
+//	                          to be shown with the group separators are passed formatted
+//	{name, plural, one {# author} other {# authors}}
+//	                          the form of the CLDR plural category of the number, or of
+//	                          the exact value like =0; # is the number with the separators
+//
+// An apostrophe before {, } or # in a plural form quotes the text up to the next
+// apostrophe, two apostrophes are one. Other apostrophes are written as is.
+type msgNode struct {
+	text  string               // literal text
+	arg   string               // name of the parameter
+	hash  bool                 // # in a plural form
+	forms map[string][]msgNode // plural forms by the category or =N
+}
+
+type msgParser struct {
+	r   []rune
+	pos int
+}
+
+func parseMessage(s string) ([]msgNode, error) {
+	p := &msgParser{r: []rune(s)}
+
+	nodes, err := p.message(false)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos < len(p.r) {
+		return nil, fmt.Errorf("unexpected } at %d", p.pos)
+	}
+	return nodes, nil
+}
+
+// message parses the text up to the end or the closing brace
+func (p *msgParser) message(in_plural bool) ([]msgNode, error) {
+	var nodes []msgNode
+	var text strings.Builder
+
+	flush := func() {
+		if text.Len() > 0 {
+			nodes = append(nodes, msgNode{text: text.String()})
+			text.Reset()
+		}
+	}
+
+	for p.pos < len(p.r) {
+		c := p.r[p.pos]
+
+		switch {
+		case c == '\'':
+			p.pos++
+			if p.pos < len(p.r) && p.r[p.pos] == '\'' {
+				text.WriteRune('\'')
+				p.pos++
+			} else if p.pos < len(p.r) && (p.r[p.pos] == '{' || p.r[p.pos] == '}' || in_plural && p.r[p.pos] == '#') {
+				for p.pos < len(p.r) && p.r[p.pos] != '\'' {
+					text.WriteRune(p.r[p.pos])
+					p.pos++
+				}
+				p.pos++ // closing apostrophe
+			} else {
+				text.WriteRune('\'')
+			}
+		case c == '}':
+			flush()
+			return nodes, nil
+		case c == '#' && in_plural:
+			flush()
+			nodes = append(nodes, msgNode{hash: true})
+			p.pos++
+		case c == '{':
+			flush()
+			n, err := p.argument()
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, n)
+		default:
+			text.WriteRune(c)
+			p.pos++
+		}
+	}
+
+	flush()
+	return nodes, nil
+}
+
+// until returns the trimmed text up to one of the runes, which is not consumed
+func (p *msgParser) until(stop string) (string, error) {
+	start := p.pos
+	for p.pos < len(p.r) && !strings.ContainsRune(stop, p.r[p.pos]) {
+		p.pos++
+	}
+
+	if p.pos == len(p.r) {
+		return "", fmt.Errorf("unclosed { at %d", start-1)
+	}
+	return strings.TrimSpace(string(p.r[start:p.pos])), nil
+}
+
+func (p *msgParser) skipSpaces() {
+	for p.pos < len(p.r) && unicode.IsSpace(p.r[p.pos]) {
+		p.pos++
+	}
+}
+
+// argument parses {name} or {name, plural, ...}
+func (p *msgParser) argument() (msgNode, error) {
+	start := p.pos
+	p.pos++ // {
+
+	name, err := p.until(",}")
+	if err != nil {
+		return msgNode{}, err
+	}
+	if name == "" {
+		return msgNode{}, fmt.Errorf("no parameter name at %d", start)
+	}
+
+	if p.r[p.pos] == '}' {
+		p.pos++
+		return msgNode{arg: name}, nil
+	}
+
+	p.pos++ // ,
+	typ, err := p.until(",}")
+	if err != nil {
+		return msgNode{}, err
+	}
+	if typ != "plural" || p.r[p.pos] != ',' {
+		return msgNode{}, fmt.Errorf("unsupported format %q of {%s}, only plural is supported", typ, name)
+	}
+	p.pos++ // ,
+
+	n := msgNode{arg: name, forms: make(map[string][]msgNode)}
+	for {
+		p.skipSpaces()
+		if p.pos == len(p.r) {
+			return msgNode{}, fmt.Errorf("unclosed { at %d", start)
+		}
+
+		if p.r[p.pos] == '}' {
+			p.pos++
+			break
+		}
+
+		selector, err := p.until("{ \t\n")
+		if err != nil {
+			return msgNode{}, err
+		}
+		if !isPluralCategory(selector) {
+			if _, err := strconv.ParseFloat(strings.TrimPrefix(selector, "="), 64); err != nil || !strings.HasPrefix(selector, "=") {
+				return msgNode{}, fmt.Errorf("invalid plural form %q of {%s}", selector, name)
+			}
+		}
+
+		p.skipSpaces()
+		if p.pos == len(p.r) || p.r[p.pos] != '{' {
+			return msgNode{}, fmt.Errorf("no text of the plural form %q of {%s}", selector, name)
+		}
+		p.pos++
+
+		form, err := p.message(true)
+		if err != nil {
+			return msgNode{}, err
+		}
+		if p.pos == len(p.r) {
+			return msgNode{}, fmt.Errorf("unclosed plural form %q of {%s}", selector, name)
+		}
+		p.pos++ // }
+
+		n.forms[selector] = form
+	}
+
+	if _, ok := n.forms[PLURAL_OTHER]; !ok {
+		return msgNode{}, fmt.Errorf("no other form of {%s}", name)
+	}
+	return n, nil
+}
+
+// FormatMessage writes the message with the parameters
+func (l *Language) FormatMessage(message string, args Args) (string, error) {
+	nodes, err := parseMessage(message)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	if err := l.render(&b, nodes, args, 0); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+func (l *Language) render(b *strings.Builder, nodes []msgNode, args Args, count float64) error {
+	for _, n := range nodes {
+		switch {
+		case n.hash:
+			b.WriteString(l.formatCount(count))
+		case n.arg == "":
+			b.WriteString(n.text)
+		default:
+			value, ok := args[n.arg]
+			if !ok {
+				return fmt.Errorf("no parameter {%s}", n.arg)
+			}
+
+			if n.forms == nil {
+				b.WriteString(fmt.Sprint(value))
+				continue
+			}
+
+			number, ok := toFloat(value)
+			if !ok {
+				return fmt.Errorf("parameter {%s} is not a number: %v", n.arg, value)
+			}
+
+			if err := l.render(b, l.pluralForm(n.forms, number), args, number); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// pluralForm selects the exact value form, the form of the category or the other one
+func (l *Language) pluralForm(forms map[string][]msgNode, n float64) []msgNode {
+	if form, ok := forms["="+strconv.FormatFloat(n, 'f', -1, 64)]; ok {
+		return form
+	}
+
+	if rule, ok := PluralRules[l.Plural]; ok {
+		if form, ok := forms[rule(n)]; ok {
+			return form
+		}
+	}
+	return forms[PLURAL_OTHER]
+}
+
+func (l *Language) formatCount(n float64) string {
+	if n == math.Trunc(n) {
+		return l.FormatNumber(strconv.FormatInt(int64(n), 10))
+	}
+	return l.FormatNumber(strconv.FormatFloat(n, 'f', -1, 64))
+}
+
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	}
+	return 0, false
+}
@@ -0,0 +1,118 @@
+package i18n
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatMessage(t *testing.T) {
+	en := Default().Get("en")
+	ru := Default().Get("ru")
+	ar := Default().Get("ar")
+
+	const books = "{n, plural, one {# книга} few {# книги} many {# книг} other {# книги}}"
+	const items = "{n, plural, zero {لا عناصر} one {عنصر} two {عنصران} few {# عناصر} many {# عنصرًا} other {# عنصر}}"
+
+	tests := []struct {
+		name    string
+		l       *Language
+		message string
+		args    Args
+		want    string
+	}{
+		{"text", en, "no parameters", nil, "no parameters"},
+		{"parameter", en, "Hello, {name}!", Args{"name": "Bob"}, "Hello, Bob!"},
+		{"parameter spaces", en, "{ name }", Args{"name": 5}, "5"},
+		{"hash outside plural", en, "#{n}", Args{"n": 1}, "#1"},
+
+		{"one", en, "{n, plural, one {# item} other {# items}}", Args{"n": 1}, "1 item"},
+		{"other", en, "{n, plural, one {# item} other {# items}}", Args{"n": 0}, "0 items"},
+		{"grouped count", en, "{n, plural, one {# item} other {# items}}", Args{"n": 12345}, "12,345 items"},
+		{"fraction count", en, "{n, plural, one {# item} other {# items}}", Args{"n": 1.5}, "1.5 items"},
+		{"exact value first", en, "{n, plural, =1 {a single item} one {# item} other {# items}}", Args{"n": 1}, "a single item"},
+		{"exact zero", en, "{n, plural, =0 {none} other {#}}", Args{"n": 0}, "none"},
+		{"exact not matched", en, "{n, plural, =0 {none} other {#}}", Args{"n": 3}, "3"},
+		{"no category form", ru, "{n, plural, one {# книга} other {# книги}}", Args{"n": 5}, "5 книги"},
+
+		{"ru 1", ru, books, Args{"n": 1}, "1 книга"},
+		{"ru 2", ru, books, Args{"n": 2}, "2 книги"},
+		{"ru 5", ru, books, Args{"n": 5}, "5 книг"},
+		{"ru 11", ru, books, Args{"n": 11}, "11 книг"},
+		{"ru 21", ru, books, Args{"n": 21}, "21 книга"},
+		{"ru 111", ru, books, Args{"n": 111}, "111 книг"},
+		{"ru 1234", ru, books, Args{"n": 1234}, "1\u00a0234 книги"},
+		{"ru 1.5", ru, books, Args{"n": 1.5}, "1,5 книги"},
+
+		{"ar 0", ar, items, Args{"n": 0}, "لا عناصر"},
+		{"ar 1", ar, items, Args{"n": 1}, "عنصر"},
+		{"ar 2", ar, items, Args{"n": 2}, "عنصران"},
+		{"ar 3", ar, items, Args{"n": 3}, "3 عناصر"},
+		{"ar 11", ar, items, Args{"n": 11}, "11 عنصرًا"},
+		{"ar 100", ar, items, Args{"n": 100}, "100 عنصر"},
+
+		{"nested parameter", en, "{n, plural, one {# post by {author}} other {# posts by {author}}}", Args{"n": 2, "author": "Ann"}, "2 posts by Ann"},
+		{"nested plural", en, "{n, plural, one {# post, {c, plural, one {# like} other {# likes}}} other {# posts, {c, plural, one {# like} other {# likes}}}}",
+			Args{"n": 2, "c": 1}, "2 posts, 1 like"},
+		{"hash of the inner plural", en, "{n, plural, other {# of {c, plural, other {#}}}}", Args{"n": 3, "c": 7}, "3 of 7"},
+		{"integer types", en, "{n, plural, one {#} other {# x}}", Args{"n": int64(1)}, "1"},
+
+		{"quoted braces", en, "'{name}' is {name}", Args{"name": "x"}, "{name} is x"},
+		{"quoted closing brace", en, "a '}' b", nil, "a } b"},
+		{"double apostrophe", en, "it''s {name}", Args{"name": "x"}, "it's x"},
+		{"single apostrophe", en, "it's", nil, "it's"},
+		{"quoted hash", en, "{n, plural, other {'#' #}}", Args{"n": 4}, "# 4"},
+		{"apostrophe before hash outside plural", en, "'#", nil, "'#"},
+		{"unclosed quote", en, "a '{b", nil, "a {b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.l.FormatMessage(tt.message, tt.args)
+			if err != nil {
+				t.Fatalf("FormatMessage(%q) error = %v", tt.message, err)
+			}
+			if got != tt.want {
+				t.Errorf("FormatMessage(%q) = %q, want %q", tt.message, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatMessageErrors(t *testing.T) {
+	en := Default().Get("en")
+
+	tests := []struct {
+		name    string
+		message string
+		args    Args
+		want    string
+	}{
+		{"unexpected brace", "a } b", nil, "unexpected } at 2"},
+		{"unclosed", "a {name", nil, "unclosed { at 2"},
+		{"unclosed plural", "{n, plural, one {#} other {#}", Args{"n": 1}, "unclosed { at 0"},
+		{"no name", "a {} b", nil, "no parameter name at 2"},
+		{"no name with format", "{, plural, other {#}}", nil, "no parameter name at 0"},
+		{"select", "{g, select, male {he} other {they}}", nil, `unsupported format "select" of {g}`},
+		{"plural without forms", "{n, plural}", nil, `unsupported format "plural" of {n}`},
+		{"invalid category", "{n, plural, several {#} other {#}}", nil, `invalid plural form "several" of {n}`},
+		{"invalid exact value", "{n, plural, =x {#} other {#}}", nil, `invalid plural form "=x" of {n}`},
+		{"number without =", "{n, plural, 1 {#} other {#}}", nil, `invalid plural form "1" of {n}`},
+		{"no form text", "{n, plural, one other {#}}", nil, `no text of the plural form "one" of {n}`},
+		{"unclosed form", "{n, plural, one {#", nil, `unclosed plural form "one" of {n}`},
+		{"no other form", "{n, plural, one {#}}", nil, "no other form of {n}"},
+		{"error in a form", "{n, plural, other {{}}}", nil, "no parameter name at 19"},
+
+		{"no parameter", "Hello, {name}!", nil, "no parameter {name}"},
+		{"no nested parameter", "{n, plural, other {# by {author}}}", Args{"n": 1}, "no parameter {author}"},
+		{"not a number", "{n, plural, other {#}}", Args{"n": "12"}, "parameter {n} is not a number"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := en.FormatMessage(tt.message, tt.args)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("FormatMessage(%q) = %q, %v, want the error %q", tt.message, got, err, tt.want)
+			}
+		})
+	}
+}
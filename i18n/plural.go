@@ -0,0 +1,80 @@
+package i18n
+
+import (
+	"math"
+	"slices"
+)
+
+// CLDR plural categories
+const (
+	PLURAL_ZERO  = "zero"
+	PLURAL_ONE   = "one"
+	PLURAL_TWO   = "two"
+	PLURAL_FEW   = "few"
+	PLURAL_MANY  = "many"
+	PLURAL_OTHER = "other"
+)
+
+// PluralRule returns the plural category of the number
+type PluralRule func(n float64) string
+
+// PluralRules are the rules of the languages by the name used in the translation files
+var PluralRules = map[string]PluralRule{
+	// English, German, Spanish...: 1 item, 2 items
+	"one_other": func(n float64) string {
+		if n == 1 {
+			return PLURAL_ONE
+		}
+		return PLURAL_OTHER
+	},
+
+	// Russian, Ukrainian, Belarusian: 1, 21 книга; 2, 22 книги; 5, 11, 25 книг; 1,5 книги
+	"east_slavic": func(n float64) string {
+		if n != math.Trunc(n) {
+			return PLURAL_OTHER
+		}
+
+		i := int64(math.Abs(n))
+		switch {
+		case i%10 == 1 && i%100 != 11:
+			return PLURAL_ONE
+		case i%10 >= 2 && i%10 <= 4 && (i%100 < 12 || i%100 > 14):
+			return PLURAL_FEW
+		}
+		return PLURAL_MANY
+	},
+
+	// Arabic: 0, 1, 2, 3-10 and 11-99 have their own forms, 100-102 take the other one
+	"arabic": func(n float64) string {
+		if n != math.Trunc(n) {
+			return PLURAL_OTHER
+		}
+
+		i := int64(math.Abs(n))
+		switch {
+		case i == 0:
+			return PLURAL_ZERO
+		case i == 1:
+			return PLURAL_ONE
+		case i == 2:
+			return PLURAL_TWO
+		case i%100 >= 3 && i%100 <= 10:
+			return PLURAL_FEW
+		case i%100 >= 11:
+			return PLURAL_MANY
+		}
+		return PLURAL_OTHER
+	},
+
+	// Chinese, Japanese: no plural forms
+	"other": func(n float64) string {
+		return PLURAL_OTHER
+	},
+}
+
+// pluralCategories are the categories a message may select
+var pluralCategories = []string{PLURAL_ZERO, PLURAL_ONE, PLURAL_TWO, PLURAL_FEW, PLURAL_MANY, PLURAL_OTHER}
+
+func isPluralCategory(s string) bool {
+	return slices.Contains(pluralCategories, s)
+}
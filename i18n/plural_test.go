@@ -0,0 +1,85 @@
+package i18n
+
+import "testing"
+
+func TestPluralRules(t *testing.T) {
+	tests := []struct {
+		rule string
+		n    float64
+		want string
+	}{
+		{"one_other", 0, PLURAL_OTHER},
+		{"one_other", 1, PLURAL_ONE},
+		{"one_other", 2, PLURAL_OTHER},
+		{"one_other", 1.5, PLURAL_OTHER},
+		{"one_other", 21, PLURAL_OTHER},
+
+		{"east_slavic", 0, PLURAL_MANY},
+		{"east_slavic", 1, PLURAL_ONE},
+		{"east_slavic", 2, PLURAL_FEW},
+		{"east_slavic", 4, PLURAL_FEW},
+		{"east_slavic", 5, PLURAL_MANY},
+		{"east_slavic", 11, PLURAL_MANY},
+		{"east_slavic", 12, PLURAL_MANY},
+		{"east_slavic", 14, PLURAL_MANY},
+		{"east_slavic", 21, PLURAL_ONE},
+		{"east_slavic", 22, PLURAL_FEW},
+		{"east_slavic", 25, PLURAL_MANY},
+		{"east_slavic", 111, PLURAL_MANY},
+		{"east_slavic", 112, PLURAL_MANY},
+		{"east_slavic", 121, PLURAL_ONE},
+		{"east_slavic", -21, PLURAL_ONE},
+		{"east_slavic", 1.5, PLURAL_OTHER},
+
+		{"arabic", 0, PLURAL_ZERO},
+		{"arabic", 1, PLURAL_ONE},
+		{"arabic", 2, PLURAL_TWO},
+		{"arabic", 3, PLURAL_FEW},
+		{"arabic", 10, PLURAL_FEW},
+		{"arabic", 11, PLURAL_MANY},
+		{"arabic", 99, PLURAL_MANY},
+		{"arabic", 100, PLURAL_OTHER},
+		{"arabic", 102, PLURAL_OTHER},
+		{"arabic", 103, PLURAL_FEW},
+		{"arabic", 111, PLURAL_MANY},
+		{"arabic", 2.5, PLURAL_OTHER},
+
+		{"other", 0, PLURAL_OTHER},
+		{"other", 1, PLURAL_OTHER},
+		{"other", 2, PLURAL_OTHER},
+	}
+
+	for _, tt := range tests {
+		if got := PluralRules[tt.rule](tt.n); got != tt.want {
+			t.Errorf("%s(%v) = %s, want %s", tt.rule, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestLocalePlurals(t *testing.T) {
+	// the built-in languages use the rules of their CLDR plural categories
+	tests := []struct {
+		lang string
+		want map[float64]string
+	}{
+		{"ru", map[float64]string{1: PLURAL_ONE, 2: PLURAL_FEW, 5: PLURAL_MANY, 11: PLURAL_MANY, 21: PLURAL_ONE, 111: PLURAL_MANY}},
+		{"uk", map[float64]string{1: PLURAL_ONE, 2: PLURAL_FEW, 5: PLURAL_MANY, 11: PLURAL_MANY, 21: PLURAL_ONE, 111: PLURAL_MANY}},
+		{"ar", map[float64]string{0: PLURAL_ZERO, 1: PLURAL_ONE, 2: PLURAL_TWO, 3: PLURAL_FEW, 11: PLURAL_MANY, 100: PLURAL_OTHER}},
+		{"en", map[float64]string{0: PLURAL_OTHER, 1: PLURAL_ONE, 2: PLURAL_OTHER}},
+		{"zh", map[float64]string{1: PLURAL_OTHER, 2: PLURAL_OTHER}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang, func(t *testing.T) {
+			rule, ok := PluralRules[Default().Languages[tt.lang].Plural]
+			if !ok {
+				t.Fatalf("no plural rule %q", Default().Languages[tt.lang].Plural)
+			}
+			for n, want := range tt.want {
+				if got := rule(n); got != want {
+					t.Errorf("plural(%v) = %s, want %s", n, got, want)
+				}
+			}
+		})
+	}
+}
@@ -1,71 +1,81 @@
 package main
 
 import (
-	"database/sql"
+	"fmt"
 	"io"
 	"net/http"
 	"os"
+	_ "time/tzdata" // the report time zones do not depend on the system database
 
 	_ "github.com/lib/pq"
 
-	"github.com/AlexNa-Holdings/savva-reports/cmn"
-	"github.com/AlexNa-Holdings/savva-reports/reports"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
-	"gopkg.in/yaml.v2"
 )
 
-func main() {
+type command struct {
+	name        string
+	description string
+	run         func(args []string) int
+}
+
+var commands = []command{
+	{"generate", "generate the report of a period for one address", cmdGenerate},
+	{"batch", "generate the reports of a period for all the active addresses", cmdBatch},
+	{"serve", "run the HTTP report service", cmdServe},
+	{"validate-config", "check the config file and the backend connections", cmdValidateConfig},
+	{"list-locales", "print the supported report languages", cmdListLocales},
+	{"check-translations", "list the translation keys missing or unused in every language", cmdCheckTranslations},
+}
 
-	type TestConfig struct {
-		DBConnection string `yaml:"db_connection"`
-		IPFSGateway  string `yaml:"ipfs_gateway"`
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: savva-reports <command> [flags]\n\nCommands:\n")
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-16s %s\n", c.name, c.description)
 	}
+	fmt.Fprintf(os.Stderr, "\nRun 'savva-reports <command> -h' for the command flags.\n")
+}
 
-	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stdout}).Level(zerolog.DebugLevel)
+func main() {
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr}).Level(zerolog.DebugLevel)
 
-	yamlFile, err := os.ReadFile("/home/alexn/savva-reports.yaml")
-	if err != nil {
-		log.Error().Err(err).Msg("Failed to read config file")
-		return
-	}
+	os.Exit(run(os.Args[1:]))
+}
 
-	// Unmarshal the YAML file into the TestConfig struct
-	var testConfig TestConfig
-	err = yaml.Unmarshal(yamlFile, &testConfig)
-	if err != nil {
-		log.Error().Err(err).Msg("Failed to unmarshal config file")
-		return
+func run(args []string) int {
+	if len(args) == 0 {
+		usage()
+		return exitUsage
 	}
 
-	IPFS_Gateway = testConfig.IPFSGateway
-	cmn.C.SavvaTokenPrice = 0.0024470
-	cmn.C.CurrencySymbol = "$"
-	cmn.C.IPFS = Ipfs
-
-	cmn.C.DB, err = sql.Open("postgres", testConfig.DBConnection)
-	if err != nil {
-		log.Fatal().Msgf("Cannot connect to DB. Error:(%v)", err)
-		return
+	switch args[0] {
+	case "-h", "-help", "--help", "help":
+		usage()
+		return exitOK
 	}
 
-	defer cmn.C.DB.Close()
+	for _, c := range commands {
+		if c.name == args[0] {
+			return c.run(args[1:])
+		}
+	}
 
-	err = reports.BuildMonthly("0xDf691828859e3Cb1e31E6D2F8A9b04F3B91A717f", 2025, 3, "AlexNaMonth.pdf", "en")
-	//err = reports.Build("0x86002b3616cD8F8DC4C3cAC51571d833810B2718", 2025, 2, "IgorMonth.pdf", "en")
-	//err = reports.Build("0xd20CEB10C3e90ba880c0a3824C9bcD1623F5D39A", 2025, 2, "AnelaMonth.pdf", "ru")
+	fmt.Fprintf(os.Stderr, "Unknown command: %s\n\n", args[0])
+	usage()
+	return exitUsage
+}
 
-	if err != nil {
-		log.Error().Err(err).Msg("Failed to build report")
+// ipfsLoader returns the function that loads files from the IPFS gateway
+func ipfsLoader(gateway string) func(cid string) []byte {
+	return func(cid string) []byte {
+		return ipfs(gateway, cid)
 	}
 }
 
-var IPFS_Gateway string
-
 // Load file from the IPFS gateway
-func Ipfs(cid string) []byte {
+func ipfs(gateway, cid string) []byte {
 
-	url := IPFS_Gateway + cid
+	url := gateway + cid
 
 	resp, err := http.Get(url)
 	if err != nil {
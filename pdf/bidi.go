@@ -0,0 +1,87 @@
+package pdf
+
+import (
+	"strings"
+
+	"github.com/AlexNa-Holdings/savva-reports/text"
+	"golang.org/x/net/html"
+)
+
+// RTL reports whether the report is laid out from right to left
+func (doc *Doc) RTL() bool {
+	return doc.Lang().RTL()
+}
+
+// direction returns the direction of the line: of the paragraph being written,
+// of the report in the right-to-left reports, of the first strong character otherwise
+func (doc *Doc) direction(s string) text.Direction {
+	switch {
+	case doc.paragraphDir != text.DIRECTION_AUTO:
+		return doc.paragraphDir
+	case doc.RTL():
+		return text.DIRECTION_RTL
+	}
+	if d, ok := text.DetectDirection(s); ok {
+		return d
+	}
+	return text.DIRECTION_LTR
+}
+
+// visual returns the line shaped and reordered for drawing from left to right
+func (doc *Doc) visual(s string) string {
+	if doc.paragraphDir != text.DIRECTION_RTL && !doc.RTL() && !text.NeedsBidi(s) {
+		return s
+	}
+	return text.Visual(text.Shape(s), doc.direction(s))
+}
+
+// align returns the alignment of the text, 'L' and 'R' are swapped for the right-to-left text
+func (doc *Doc) align(a uint8, s string) uint8 {
+	if doc.direction(s) != text.DIRECTION_RTL {
+		return a
+	}
+	switch a {
+	case 'C':
+		return 'C'
+	case 'R':
+		return 'L'
+	}
+	return 'R'
+}
+
+// MirrorX returns the x of the box of the width w laid out from the left margin, in the
+// right-to-left reports the box is mirrored to the same distance from the right margin
+func (doc *Doc) MirrorX(x, w float64) float64 {
+	if !doc.RTL() {
+		return x
+	}
+	return doc.Margins.Left + doc.PageWidth - doc.Margins.Right - x - w
+}
+
+// blockDirection returns the direction of the Markdown block by its first strong
+// character, the blocks without one have the direction of the report
+func (doc *Doc) blockDirection(n *html.Node) text.Direction {
+	if d, ok := text.DetectDirection(nodeText(n)); ok {
+		return d
+	}
+	if doc.RTL() {
+		return text.DIRECTION_RTL
+	}
+	return text.DIRECTION_LTR
+}
+
+// nodeText returns the text of the node and its children
+func nodeText(n *html.Node) string {
+	var b strings.Builder
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			b.WriteString(n.Data)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return b.String()
+}
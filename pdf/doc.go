@@ -1,11 +1,18 @@
 package pdf
 
 import (
+	"fmt"
 	"image"
+	"maps"
+	"slices"
+	"time"
 
 	"github.com/AlexNa-Holdings/savva-reports/assets"
+	"github.com/AlexNa-Holdings/savva-reports/cmn"
+	"github.com/AlexNa-Holdings/savva-reports/currency"
 	"github.com/AlexNa-Holdings/savva-reports/data"
 	"github.com/AlexNa-Holdings/savva-reports/i18n"
+	"github.com/AlexNa-Holdings/savva-reports/text"
 	"github.com/rs/zerolog/log"
 	"github.com/signintech/gopdf"
 )
@@ -22,6 +29,8 @@ type Style struct {
 	Padding   PaddingDescription
 	Align     uint8 // 'L', 'C', 'R'
 	MinHeight float64
+	Justify   bool // the wrapped lines of the Markdown paragraphs fill the width
+	Hyphenate bool // the words of the Markdown paragraphs are hyphenated at the ends of the lines
 }
 
 type Color struct {
@@ -30,6 +39,7 @@ type Color struct {
 
 var SAVVA_COLOR Color = Color{0xff, 0x71, 0x00}
 var SAVVA_DARK_COLOR Color = Color{0xc4, 0x80, 0x00}
+var WARNING_COLOR Color = Color{0xc6, 0x28, 0x28}
 
 type Section struct {
 	Title       string
@@ -37,9 +47,15 @@ type Section struct {
 	SubSections []*Section
 }
 
+// Doc is the rendering context of one report. Nothing in it is shared with
+// other reports, so several documents can be rendered in parallel.
 type Doc struct { // Extended gopdf.GoPdf
 	*gopdf.GoPdf
+	Config      *cmn.Config
+	Data        data.Source
 	Locale      string
+	Currency    currency.Currency
+	Location    *time.Location // time zone of the dates and the times
 	CurentPage  int
 	UserAddress string
 	Sections    []*Section
@@ -48,6 +64,10 @@ type Doc struct { // Extended gopdf.GoPdf
 	PageWidth, PageHeight              float64
 	Section, SubSection, SubSubSection int
 	PrintHeader                        bool
+	TableHeaderStyle                   Style
+	TableCellStyle                     Style
+	TableGroupStyle                    Style
+	PostStyle                          Style // of the content of the posts
 
 	// data
 	History   []data.HistoryRecord
@@ -59,15 +79,48 @@ type Doc struct { // Extended gopdf.GoPdf
 	skip_newline bool
 	style        Style
 	styles       []Style
+	font         string // current font of gopdf, see SetFont
+	fontStyle    string
+	fontSize     any
+	fonts        map[string]bool   // added to gopdf, see loadFont
+	paragraphDir text.Direction    // of the paragraph being written, DIRECTION_AUTO if none, see direction
+	line         []mdRun           // of the Markdown paragraph waiting for the justification, see flushLine
+	md           mdState           // of the Markdown being written
+	strike       int               // depth of the struck out Markdown elements
+	prices       map[int64]float64 // unix time -> token price in the report currency
+	translations *i18n.Catalog
+	missing      map[string]bool // keys without the translation to the locale
+	priceErr     error           // the first price or exchange rate that is missing, see PriceError
 	GetImage     func(string) (image.Image, error)
 }
 
-func NewDoc(user_addr, locale string) (*Doc, error) {
+func NewDoc(cfg *cmn.Config, user_addr, locale, currency_code string) (*Doc, error) {
+	if currency_code == "" {
+		currency_code = cfg.Currency
+	}
+
+	cur, err := currency.Get(currency_code)
+	if err != nil {
+		return nil, err
+	}
+
+	if cur.Code != currency.USD && cfg.FX == nil {
+		return nil, fmt.Errorf("no exchange rates configured for %s", cur.Code)
+	}
+
+	translations := cfg.Locales()
+	if !translations.Has(locale) {
+		return nil, fmt.Errorf("unsupported locale %q", locale)
+	}
+
 	// Create a new PDF document.
 	doc := Doc{
 		GoPdf:       new(gopdf.GoPdf),
+		Config:      cfg,
 		UserAddress: user_addr,
 		Locale:      locale,
+		Currency:    cur,
+		Location:    time.UTC,
 		CurentPage:  0,
 		style: Style{
 			FontName:  "Arial",
@@ -78,6 +131,22 @@ func NewDoc(user_addr, locale string) (*Doc, error) {
 		Section:     -1,
 		SubSection:  -1,
 		PrintHeader: true,
+
+		TableHeaderStyle: DefaultTableHeaderStyle(),
+		TableCellStyle:   DefaultTableCellStyle(),
+		TableGroupStyle:  DefaultTableGroupStyle(),
+		PostStyle:        DefaultPostStyle(),
+
+		paragraphDir: text.DIRECTION_AUTO,
+		translations: translations,
+		missing:      make(map[string]bool),
+		fonts:        make(map[string]bool),
+	}
+
+	doc.Data, err = data.NewSource(cfg)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to initialize data source")
+		return nil, err
 	}
 
 	doc.Start(gopdf.Config{PageSize: *gopdf.PageSizeA4})
@@ -86,10 +155,12 @@ func NewDoc(user_addr, locale string) (*Doc, error) {
 
 	doc.PageWidth, doc.PageHeight = gopdf.PageSizeA4.W, gopdf.PageSizeA4.H
 
-	// Load all fonts
-	for name, font := range assets.AllFonts {
-		err := doc.AddTTFFontData(name, font)
-		if err != nil {
+	// Load the fonts but the lazy ones
+	for name := range assets.AllFonts {
+		if slices.Contains(LAZY_FONTS, name) {
+			continue
+		}
+		if err := doc.loadFont(name); err != nil {
 			log.Error().Err(err).Msgf("Failed to load font %s", name)
 			return nil, err
 		}
@@ -105,8 +176,42 @@ func NewDoc(user_addr, locale string) (*Doc, error) {
 	return &doc, nil
 }
 
+// Lang returns the language of the report with its formats
+func (doc *Doc) Lang() *i18n.Language {
+	return doc.translations.Get(doc.Locale)
+}
+
+// T returns the translation of the key. The keys missing in the locale are
+// translated to the fallback language and remembered, see MissingKeys.
 func (doc *Doc) T(key string) string {
-	return i18n.T(key, doc.Locale)
+	value, ok := doc.translations.Lookup(key, doc.Locale)
+	if !ok && !doc.missing[key] {
+		log.Warn().Msgf("No %s translation for %q", doc.Locale, key)
+		doc.missing[key] = true
+	}
+	return value
+}
+
+// Tf returns the translation of the key formatted with the named parameters, see i18n.Args
+func (doc *Doc) Tf(key string, args i18n.Args) string {
+	message := doc.T(key)
+	s, err := doc.Lang().FormatMessage(message, args)
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to format %q", key)
+		return message
+	}
+	return s
+}
+
+// MissingKeys returns the keys used so far that have no translation to the locale
+func (doc *Doc) MissingKeys() []string {
+	return slices.Sorted(maps.Keys(doc.missing))
+}
+
+// PriceError returns the error of the first token price or exchange rate that is missing
+// for the values of the report, nil if all of them are known
+func (doc *Doc) PriceError() error {
+	return doc.priceErr
 }
 
 func (doc *Doc) SetDocFont(fontName string, size float64) {
@@ -0,0 +1,153 @@
+package pdf
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/AlexNa-Holdings/savva-reports/assets"
+	"github.com/AlexNa-Holdings/savva-reports/text"
+	"github.com/rs/zerolog/log"
+	"github.com/signintech/gopdf/fontmaker/core"
+)
+
+// FALLBACK_FONTS are tried in order for the characters missing in the current font
+var FALLBACK_FONTS = []string{"Arial", "DejaVuBold", "NotoSansSC"}
+
+// LAZY_FONTS are added to the document the first time they are used, so the reports
+// without their characters do not parse them, see loadFont
+var LAZY_FONTS = []string{"NotoSansSC"}
+
+// fontChars returns the characters of every embedded font
+var fontChars = sync.OnceValue(func() map[string]map[int]uint {
+	r := make(map[string]map[int]uint, len(assets.AllFonts))
+	for name, font := range assets.AllFonts {
+		var parser core.TTFParser
+		if err := parser.ParseFontData(font); err != nil {
+			panic(fmt.Sprintf("invalid font %s: %v", name, err))
+		}
+		r[name] = parser.Chars()
+	}
+	return r
+})
+
+// HasGlyph reports whether the embedded font has the glyph of the character
+func HasGlyph(font string, r rune) bool {
+	return fontChars()[font][int(r)] != 0
+}
+
+// fontRun is a part of the text drawn with one font
+type fontRun struct {
+	font string
+	text string
+}
+
+// fontFor returns the current font if it has the glyph, otherwise the first fallback
+// font that has it. The spaces and the characters no font has stay in the current font.
+func (doc *Doc) fontFor(r rune) string {
+	if HasGlyph(doc.font, r) {
+		return doc.font
+	}
+	for _, font := range FALLBACK_FONTS {
+		if HasGlyph(font, r) {
+			if err := doc.loadFont(font); err != nil {
+				log.Error().Err(err).Msgf("Failed to load font %s", font)
+				return doc.font
+			}
+			return font
+		}
+	}
+	return doc.font
+}
+
+// loadFont adds the embedded font to the document unless it is added already
+func (doc *Doc) loadFont(name string) error {
+	if doc.fonts[name] {
+		return nil
+	}
+
+	font, ok := assets.AllFonts[name]
+	if !ok {
+		return fmt.Errorf("unknown font %s", name)
+	}
+	if err := doc.AddTTFFontData(name, font); err != nil {
+		return err
+	}
+
+	doc.fonts[name] = true
+	return nil
+}
+
+// fontRuns splits the text into the runs of the same font
+func (doc *Doc) fontRuns(text string) []fontRun {
+	var runs []fontRun
+	start, font := 0, doc.font
+	for i, r := range text {
+		f := doc.fontFor(r)
+		if f != font && i > start {
+			runs = append(runs, fontRun{font, text[start:i]})
+			start = i
+		}
+		font = f
+	}
+	if start < len(text) {
+		runs = append(runs, fontRun{font, text[start:]})
+	}
+	return runs
+}
+
+// SetFont sets the font of the text, the characters missing in it are drawn with
+// the FALLBACK_FONTS
+func (doc *Doc) SetFont(family string, style string, size any) error {
+	if err := doc.loadFont(family); err != nil {
+		return err
+	}
+	if err := doc.GoPdf.SetFont(family, style, size); err != nil {
+		return err
+	}
+	doc.font, doc.fontStyle, doc.fontSize = family, style, size
+	return nil
+}
+
+// Text writes the line at the current position with the fonts that have its glyphs.
+// The Arabic letters are shaped and the right-to-left runs reordered, see visual.
+func (doc *Doc) Text(s string) error {
+	s = doc.visual(s)
+	runs := doc.fontRuns(s)
+	if len(runs) == 0 || len(runs) == 1 && runs[0].font == doc.font {
+		return doc.GoPdf.Text(s)
+	}
+
+	defer doc.GoPdf.SetFont(doc.font, doc.fontStyle, doc.fontSize)
+	for _, run := range runs {
+		if err := doc.GoPdf.SetFont(run.font, "", doc.fontSize); err != nil {
+			return err
+		}
+		if err := doc.GoPdf.Text(run.text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MeasureTextWidth returns the width of the text written with Text
+func (doc *Doc) MeasureTextWidth(s string) (float64, error) {
+	s = text.Shape(s)
+	runs := doc.fontRuns(s)
+	if len(runs) == 0 || len(runs) == 1 && runs[0].font == doc.font {
+		return doc.GoPdf.MeasureTextWidth(s)
+	}
+
+	defer doc.GoPdf.SetFont(doc.font, doc.fontStyle, doc.fontSize)
+	var width float64
+	for _, run := range runs {
+		if err := doc.GoPdf.SetFont(run.font, "", doc.fontSize); err != nil {
+			return 0, err
+		}
+		w, err := doc.GoPdf.MeasureTextWidth(run.text)
+		if err != nil {
+			return 0, err
+		}
+		width += w
+	}
+	return width, nil
+}
@@ -0,0 +1,27 @@
+package pdf
+
+import "testing"
+
+func TestLazyFonts(t *testing.T) {
+	doc := newTestDoc(t, "en")
+
+	if doc.fonts["NotoSansSC"] {
+		t.Fatal("NotoSansSC is added before it is used")
+	}
+
+	textWidth(t, doc, "Balance 1,234.50")
+	if doc.fonts["NotoSansSC"] {
+		t.Error("NotoSansSC is added for the Latin text")
+	}
+
+	if w := textWidth(t, doc, "余额 1,234.50"); w <= textWidth(t, doc, " 1,234.50") {
+		t.Errorf("the Chinese characters have no width, %v", w)
+	}
+	if !doc.fonts["NotoSansSC"] {
+		t.Error("NotoSansSC is not added for the Chinese text")
+	}
+
+	if err := doc.SetFont("NoSuchFont", "", 12); err == nil {
+		t.Error("SetFont() of an unknown font returned no error")
+	}
+}
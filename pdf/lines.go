@@ -0,0 +1,132 @@
+package pdf
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/AlexNa-Holdings/savva-reports/text"
+)
+
+// isBreakSpace reports whether the line may break at the space, the no-break spaces
+// of the numbers and the units are not
+func isBreakSpace(r rune) bool {
+	return unicode.IsSpace(r) && r != '\u00a0' && r != '\u202f'
+}
+
+// trimBreak drops the spaces and the line feeds at the end of the line
+func trimBreak(line string) string {
+	return strings.TrimRightFunc(line, isBreakSpace)
+}
+
+// collapseSpaces replaces the runs of the white space of the HTML text with a single
+// space, the line feeds at the ends of the text are dropped
+func collapseSpaces(s string) string {
+	s = strings.Trim(s, "\r\n")
+
+	var b strings.Builder
+	space := false
+	for _, r := range s {
+		if isBreakSpace(r) {
+			space = true
+			continue
+		}
+		if space {
+			b.WriteByte(' ')
+			space = false
+		}
+		b.WriteRune(r)
+	}
+	if space {
+		b.WriteByte(' ')
+	}
+	return b.String()
+}
+
+// fitLine returns the beginning of the text that fits the width, broken at the last line
+// break opportunity or hyphen before it, and the rest of the text. The spaces at the break
+// are dropped. At the start of a line the text is broken between the graphemes if it has no
+// opportunity that fits, otherwise the line is empty and the text goes to the next one.
+func (doc *Doc) fitLine(s string, width float64, start bool) (string, string) {
+	end := 0              // of the line that fits so far
+	var lineWidth float64 // of the text before the end with the spaces
+
+	for _, b := range text.LineBreaks(s) {
+		segment := s[end:b.Pos]
+		w, _ := doc.MeasureTextWidth(trimBreak(segment))
+		if lineWidth+w > width {
+			if line, rest, ok := doc.hyphenate(s, end, b.Pos, width-lineWidth); ok {
+				return line, rest
+			}
+			break
+		}
+
+		switch {
+		case b.Mandatory:
+			return trimBreak(s[:b.Pos]), s[b.Pos:]
+		case b.Pos == len(s):
+			return s, ""
+		}
+
+		sw, _ := doc.MeasureTextWidth(segment)
+		lineWidth += sw
+		end = b.Pos
+	}
+
+	if end > 0 {
+		return trimBreak(s[:end]), s[end:]
+	}
+	if !start {
+		return "", s
+	}
+
+	// no opportunity fits, the line ends at the last grapheme that does, one at least
+	breaks := text.GraphemeBreaks(s)
+	end = breaks[0]
+	for _, pos := range breaks[1:] {
+		if w, _ := doc.MeasureTextWidth(s[:pos]); w > width {
+			break
+		}
+		end = pos
+	}
+	return s[:end], s[end:]
+}
+
+// hyphenate breaks the segment of the text from start to end at the last hyphen of its
+// word that fits the width if the style hyphenates the words, ok is false otherwise
+func (doc *Doc) hyphenate(s string, start, end int, width float64) (line, rest string, ok bool) {
+	if !doc.style.Hyphenate {
+		return "", "", false
+	}
+
+	// the word without the punctuation around it
+	segment := trimBreak(s[start:end])
+	from := strings.IndexFunc(segment, unicode.IsLetter)
+	if from < 0 {
+		return "", "", false
+	}
+	to := strings.IndexFunc(segment[from:], func(r rune) bool { return !unicode.IsLetter(r) })
+	if to < 0 {
+		to = len(segment) - from
+	}
+
+	hyphens := text.Hyphenate(doc.Locale, segment[from:from+to])
+	for i := len(hyphens) - 1; i >= 0; i-- {
+		cut := start + from + hyphens[i]
+		if w, _ := doc.MeasureTextWidth(s[start:cut] + "-"); w <= width {
+			return s[:cut] + "-", s[cut:], true
+		}
+	}
+	return "", "", false
+}
+
+// breakLines splits the text into the lines that fit the width, the spaces at their
+// ends are dropped
+func (doc *Doc) breakLines(s string, width float64) []string {
+	var lines []string
+	for s != "" {
+		var line string
+		line, s = doc.fitLine(s, width, true)
+		lines = append(lines, trimBreak(line))
+	}
+	return lines
+}
@@ -0,0 +1,152 @@
+package pdf
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/AlexNa-Holdings/savva-reports/cmn"
+	"github.com/AlexNa-Holdings/savva-reports/text"
+)
+
+func newTestDoc(t *testing.T, locale string) *Doc {
+	t.Helper()
+
+	cfg := &cmn.Config{Currency: "USD", FixturesDir: "../fixtures/demo"}
+	doc, err := NewDoc(cfg, "0x0", locale, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc.SetDocFont("Times", 12)
+	return doc
+}
+
+func textWidth(t *testing.T, doc *Doc, s string) float64 {
+	t.Helper()
+
+	w, err := doc.MeasureTextWidth(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w
+}
+
+func TestFitLine(t *testing.T) {
+	doc := newTestDoc(t, "en")
+
+	tests := []struct {
+		name  string
+		s     string
+		width string // the text as wide as the line
+		start bool
+		line  string
+		rest  string
+	}{
+		{"fits", "Привет, мир", "Привет, мир", true, "Привет, мир", ""},
+		{"cyrillic", "Привет, мир", "Привет, ми", true, "Привет,", "мир"},
+		{"cyrillic two words", "один два три", "один два т", true, "один два", "три"},
+		{"line feed", "один\nдва", "один два", true, "один", "два"},
+		{"cjk", "日本語の文章", "日本語", true, "日本語", "の文章"},
+		{"cjk kinsoku", "日本。語", "日本", true, "日", "本。語"},
+		{"emoji zwj", "👨‍👩‍👧 семья", "👨‍👩‍👧 се", true, "👨‍👩‍👧", "семья"},
+		{"flags", "🇺🇦🇩🇪🇫🇷", "🇺🇦🇩🇪", true, "🇺🇦🇩🇪", "🇫🇷"},
+		{"long word", "Достопримечательность", "Достоп", true, "Достоп", "римечательность"},
+		{"long word after text", "Достопримечательность", "Достоп", false, "", "Достопримечательность"},
+		{"long zwj emoji", "👨‍👩‍👧👨‍👩‍👧", "👨", true, "👨‍👩‍👧", "👨‍👩‍👧"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line, rest := doc.fitLine(tt.s, textWidth(t, doc, tt.width), tt.start)
+			if line != tt.line || rest != tt.rest {
+				t.Errorf("fitLine(%q) = %q, %q, want %q, %q", tt.s, line, rest, tt.line, tt.rest)
+			}
+		})
+	}
+}
+
+func TestFitLineHyphenate(t *testing.T) {
+	doc := newTestDoc(t, "ru")
+	doc.style.Hyphenate = true
+
+	s := "очень достопримечательность"
+	line, rest := doc.fitLine(s, textWidth(t, doc, "очень достопримеча-"), true)
+	if line != "очень достопримеча-" || rest != "тельность" {
+		t.Errorf("fitLine(%q) = %q, %q", s, line, rest)
+	}
+}
+
+func TestBreakLines(t *testing.T) {
+	doc := newTestDoc(t, "en")
+
+	s := "Съешь же ещё этих мягких французских булок 🇫🇷"
+	width := textWidth(t, doc, "Съешь же ещё этих")
+	lines := doc.breakLines(s, width)
+
+	joined := ""
+	for i, line := range lines {
+		if w := textWidth(t, doc, line); w > width {
+			t.Errorf("line %d %q is %.1f wide, more than %.1f", i, line, w, width)
+		}
+		if i > 0 {
+			joined += " "
+		}
+		joined += line
+	}
+	if joined != s {
+		t.Errorf("breakLines(%q) = %q", s, lines)
+	}
+}
+
+func TestEclipseToWidth(t *testing.T) {
+	doc := newTestDoc(t, "en")
+
+	tests := []struct {
+		name  string
+		s     string
+		width string // the text as wide as the box
+		want  string
+	}{
+		{"fits", "Привет", "Привет", "Привет"},
+		{"cyrillic", "Привет, мир", "Привет...", "Привет..."},
+		{"space before the ellipsis", "Привет мир", "Привет м", "Привет..."},
+		{"cjk", "日本語の文章", "日本語...", "日本語..."},
+		{"nothing fits", "Привет", ".", "..."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, w := doc.EclipseToWidth(tt.s, textWidth(t, doc, tt.width))
+			if got != tt.want {
+				t.Errorf("EclipseToWidth(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("EclipseToWidth(%q) = %q is not valid UTF-8", tt.s, got)
+			}
+			if ww := textWidth(t, doc, got); ww != w {
+				t.Errorf("EclipseToWidth(%q) width %.1f, want %.1f", tt.s, w, ww)
+			}
+		})
+	}
+}
+
+// TestEclipseToWidthGraphemes checks that the text is cut only between the graphemes at any width
+func TestEclipseToWidthGraphemes(t *testing.T) {
+	doc := newTestDoc(t, "en")
+
+	for _, s := range []string{"Мир👨‍👩‍👧‍👦мир", "Мир🇺🇦🇩🇪🇫🇷мир", "е\u0301е\u0301е\u0301", "日本語👍🏽の文章"} {
+		breaks := text.GraphemeBreaks(s)
+		full := textWidth(t, doc, s)
+		for width := 0.; width < full; width++ {
+			got, w := doc.EclipseToWidth(s, width)
+			cut, ok := strings.CutSuffix(got, "...")
+			if !ok || !strings.HasPrefix(s, cut) || cut != "" && !slices.Contains(breaks, len(cut)) {
+				t.Errorf("EclipseToWidth(%q, %.0f) = %q is not cut between the graphemes", s, width, got)
+			}
+			if cut != "" && w > width {
+				t.Errorf("EclipseToWidth(%q, %.0f) = %q is %.1f wide", s, width, got, w)
+			}
+		}
+	}
+}
@@ -2,13 +2,36 @@ package pdf
 
 import (
 	"bytes"
+	"strconv"
 	"strings"
 
+	"github.com/AlexNa-Holdings/savva-reports/text"
 	"github.com/rs/zerolog/log"
 	"github.com/russross/blackfriday/v2"
 	"golang.org/x/net/html"
 )
 
+// BULLETS are the markers of the items of the unordered lists by the nesting level
+var BULLETS = []string{"•", "◦", "▪"}
+
+// heading font sizes relative to the paragraph
+var HEADING_SIZES = map[string]float64{"h1": 1.5, "h2": 1.2, "h3": 1.1, "h4": 1.05, "h5": 1, "h6": 0.9}
+
+// mdState is the layout of the Markdown being written, see MarkDownToPdfEx
+type mdState struct {
+	left, right float64 // of the box, the right-to-left lines are mirrored in it
+	bottom      float64 // the lines below it go to the next page or are not written
+	autoPage    bool
+}
+
+// mdBlock is the box of the children of the Markdown element
+type mdBlock struct {
+	x, w float64
+	top  float64 // y at the start of the element
+	page int     // at the start of the element
+	skip bool    // the children are written by the element itself
+}
+
 // MarkDownToPdf converts Markdown text into PDF content.
 func (doc *Doc) MarkDownToPdf(md string) error {
 	// htmlData := blackfriday.Run([]byte(md))
@@ -23,6 +46,31 @@ func (doc *Doc) MarkDownToPdf(md string) error {
 	return doc.MarkDownToPdfEx(md, doc.Margins.Left, doc.GetY(), doc.GetMarginWidth(), doc.GetMarginHeight()-doc.GetY(), true)
 }
 
+// DefaultPostStyle is the style of the content of the posts: justified and hyphenated
+func DefaultPostStyle() Style {
+	return Style{
+		FontName:  "Times",
+		FontSize:  12,
+		FontColor: &Color{0, 0, 0},
+		Justify:   true,
+		Hyphenate: true,
+	}
+}
+
+// MarkDownToPdfWithStyle converts Markdown text into PDF content with the font, the color
+// and the paragraph settings of the style
+func (doc *Doc) MarkDownToPdfWithStyle(md string, style *Style) error {
+	doc.saveStyle()
+	defer doc.restoreStyle()
+
+	doc.SetDocFont(style.FontName, style.FontSize)
+	doc.SetColor(style.FontColor)
+	doc.style.Justify = style.Justify
+	doc.style.Hyphenate = style.Hyphenate
+
+	return doc.MarkDownToPdf(md)
+}
+
 func (doc *Doc) MarkDownToPdfEx(md string, x, y, w, h float64, auto_page bool) error {
 	htmlData := blackfriday.Run([]byte(md), blackfriday.WithExtensions(
 		blackfriday.CommonExtensions|blackfriday.HardLineBreak,
@@ -35,129 +83,281 @@ func (doc *Doc) MarkDownToPdfEx(md string, x, y, w, h float64, auto_page bool) e
 	text_height, _ := doc.MeasureCellHeightByText("A")
 	doc.SetXY(x, y+text_height)
 
-	doc.renderNode(node, x, y, w, h, auto_page, doc.style.FontSize)
+	state, paragraphDir := doc.md, doc.paragraphDir
+	doc.md = mdState{left: x, right: x + w, bottom: y + h, autoPage: auto_page}
+	doc.renderNode(node, x, w, doc.style.FontSize)
+	doc.md, doc.paragraphDir = state, paragraphDir
 	doc.NewLine()
 
 	return nil
 }
 
-func (doc *Doc) renderNode(n *html.Node, x, y, w, h float64, auto_page bool, fontSize float64) (float64, float64, float64, float64) {
+func (doc *Doc) renderNode(n *html.Node, x, w float64, fontSize float64) {
 	if n == nil {
-		return x, y, w, h
+		return
 	}
 
+	// the boxes move with the margins to the next pages
+	left := doc.md.left
+
+	block := mdBlock{x: x, w: w}
 	switch n.Type {
 	case html.ElementNode:
-		doc.handleElementStart(n, x, fontSize)
+		block = doc.handleElementStart(n, x, w, fontSize)
 	case html.TextNode:
 		if n.Data != "" {
-			x, y, w, h = doc.writeText(n.Data, x, y, w, h, auto_page)
+			doc.writeText(n.Data, x, w)
 		}
 	}
 
-	for child := n.FirstChild; child != nil; child = child.NextSibling {
-		x, y, w, h = doc.renderNode(child, x, y, w, h, auto_page, fontSize)
+	for child := n.FirstChild; child != nil && !block.skip; child = child.NextSibling {
+		doc.renderNode(child, block.x+doc.md.left-left, block.w, fontSize)
 	}
 
 	if n.Type == html.ElementNode {
-		doc.handleElementEnd(n, x)
+		doc.handleElementEnd(n, x+doc.md.left-left, w, block)
 	}
+}
+
+func (doc *Doc) writeText(s string, x, w float64) {
+	s = collapseSpaces(s)
+
+	for s != "" {
+		// Check for page break
+		if doc.GetY() > doc.md.bottom {
+			if !doc.md.autoPage {
+				return
+			}
+			x += doc.mdNextPage()
+			doc.SetX(x)
+		}
+
+		line, rest := doc.fitLine(s, x+w-doc.GetX(), doc.GetX() <= x)
+		s = rest
+
+		doc.mdText(line, x, w)
+		if rest != "" {
+			doc.flushLine(true)
+			doc.NewLine()
+			doc.SetX(x)
+		}
+	}
+}
+
+// mdNextPage continues the Markdown on the next page and returns the shift of the boxes
+// with the margins
+func (doc *Doc) mdNextPage() float64 {
+	left := doc.Margins.Left
+	doc.NextPage()
+	return doc.mdFollowPage(left)
+}
 
-	return x, y, w, h
+// mdFollowPage moves the boxes of the Markdown with the margins of the new page from the
+// left margin of the last one and returns the shift
+func (doc *Doc) mdFollowPage(left float64) float64 {
+	dx := doc.Margins.Left - left
+	doc.md.left += dx
+	doc.md.right += dx
+	doc.md.bottom = doc.GetMarginHeight()
+	return dx
 }
 
-func (doc *Doc) writeText(text string, x, y, w, h float64, auto_page bool) (float64, float64, float64, float64) {
-	words := strings.Fields(text)
+// mdRun is the text of the Markdown line written with one style
+type mdRun struct {
+	s      string
+	x, y   float64 // of the text
+	right  float64 // of the box
+	style  Style
+	strike bool
+}
 
-	// add leading space
-	if len(text) > 0 && text[0] == ' ' {
-		words = append([]string{" "}, words...)
+// mdMirror returns the x of the box in the Markdown, the boxes of the right-to-left
+// blocks are at the same distance from the right side
+func (doc *Doc) mdMirror(x, w float64) float64 {
+	if doc.paragraphDir != text.DIRECTION_RTL {
+		return x
 	}
+	return doc.md.left + doc.md.right - x - w
+}
 
-	// add trailing space
-	if text[len(text)-1] == ' ' {
-		words = append(words, " ")
+// mdText writes the text at the current position of the Markdown block. The position
+// is the distance from the left side of the box x, w or from its right side in the
+// right-to-left blocks. The text of the justified paragraphs is kept until the end of
+// the line, see flushLine.
+func (doc *Doc) mdText(s string, x, w float64) {
+	tw, _ := doc.MeasureTextWidth(s)
+	pos := doc.GetX()
+
+	if doc.paragraphDir != text.DIRECTION_RTL && doc.style.Justify {
+		doc.line = append(doc.line, mdRun{s: s, x: pos, y: doc.GetY(), right: x + w, style: doc.style, strike: doc.strike > 0})
+		doc.SetX(pos + tw)
+		return
 	}
 
-	for len(words) > 0 {
-		maxWidth := x + w - doc.GetX()
+	doc.SetX(doc.mdMirror(pos, tw))
+	doc.Text(s)
+	if doc.strike > 0 {
+		doc.strikeOut(doc.mdMirror(pos, tw), tw)
+	}
+	doc.SetX(pos + tw)
+}
 
-		line, _, remainingWords := doc.wrapText(words, maxWidth)
-		words = remainingWords
+// strikeOut crosses out the text of the width w written at x on the current line
+func (doc *Doc) strikeOut(x, w float64) {
+	y := doc.GetY() - doc.style.FontSize*0.3
+	doc.SetStrokeColor(doc.style.FontColor.R, doc.style.FontColor.G, doc.style.FontColor.B)
+	doc.SetLineWidth(doc.style.FontSize * 0.06)
+	doc.Line(x, y, x+w, y)
+}
 
-		// Check for page break
-		if doc.GetY() > y+h {
-			if auto_page {
-				doc.NextPage()
-				x = doc.Margins.Left
-				w = doc.GetMarginWidth()
-				h = doc.GetMarginHeight() - doc.GetY()
-				return x, y, w, h
-			} else {
-				return x, y, w, h
+// flushLine writes the kept line of the justified paragraph. The spaces of the line are
+// stretched to the right side of the box if it is justified and has no right-to-left text.
+func (doc *Doc) flushLine(justify bool) {
+	if len(doc.line) == 0 {
+		return
+	}
+	runs := doc.line
+	doc.line = nil
+
+	doc.saveStyle()
+	defer doc.restoreStyle()
+
+	// the spaces at the end of the line are not stretched
+	last := &runs[len(runs)-1]
+	last.s = trimBreak(last.s)
+
+	var gap float64 // added to each space
+	spaces := 0
+	for _, run := range runs {
+		if text.NeedsBidi(run.s) {
+			justify = false
+		}
+		spaces += strings.Count(run.s, " ")
+	}
+	if justify && spaces > 0 {
+		doc.applyStyle(&last.style)
+		tw, _ := doc.MeasureTextWidth(last.s)
+		gap = max(last.right-last.x-tw, 0) / float64(spaces)
+	}
+
+	var shift float64 // of the run by the stretched spaces before it
+	for _, run := range runs {
+		doc.applyStyle(&run.style)
+
+		// the words are written with the spaces after them for the text to be copied with them
+		start := run.x + shift
+		x, end := start, start
+		words := strings.SplitAfter(run.s, " ")
+		if gap == 0 {
+			words = []string{run.s}
+		}
+		for i, word := range words {
+			if word != "" {
+				doc.SetXY(x, run.y)
+				doc.Text(word)
+				ww, _ := doc.MeasureTextWidth(word)
+				x += ww
+				end = x
+			}
+			if i < len(words)-1 {
+				x += gap
+				shift += gap
 			}
 		}
 
-		doc.Text(line)
-		if len(remainingWords) > 0 {
-			doc.NewLine()
-			doc.SetX(x)
+		if run.strike {
+			doc.SetY(run.y)
+			doc.strikeOut(start, end-start)
 		}
 	}
+}
 
-	return x, y, w, h
+// applyStyle sets the font and the color of the style
+func (doc *Doc) applyStyle(style *Style) {
+	doc.SetDocFont(style.FontName, style.FontSize)
+	doc.SetColor(style.FontColor)
 }
 
-func (doc *Doc) handleElementStart(n *html.Node, x, fontSize float64) {
+// startBlock starts the block element on a new line, on the next page if the line is below
+// the Markdown, and returns x moved with the margins
+func (doc *Doc) startBlock(x float64) float64 {
+	doc.NewLine()
+	if doc.GetY() > doc.md.bottom && doc.md.autoPage {
+		x += doc.mdNextPage()
+	}
+	doc.SetX(x)
+	return x
+}
+
+func (doc *Doc) handleElementStart(n *html.Node, x, w, fontSize float64) mdBlock {
 
 	// log.Debug().Msgf("< %s", n.Data)
 
 	doc.saveStyle()
 
 	switch n.Data {
-	case "h1":
-		doc.SetDocFont("TimesBold", fontSize*1.5)
-		if doc.GetX() > x {
-			doc.NewLine()
-			doc.SetX(x)
-		}
-	case "h2":
-		doc.SetDocFont("TimesBold", fontSize*1.2)
+	case "h1", "h2", "h3", "h4", "h5", "h6", "p", "li", "ul", "ol", "blockquote", "table":
+		doc.paragraphDir = doc.blockDirection(n)
+	}
+
+	block := mdBlock{x: x, w: w, top: doc.GetY(), page: doc.CurentPage}
+
+	switch n.Data {
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		doc.SetDocFont("TimesBold", fontSize*HEADING_SIZES[n.Data])
 		if doc.GetX() > x {
-			doc.NewLine()
-			doc.SetX(x)
+			doc.startBlock(x)
 		}
 	case "p":
 		doc.SetDocFont("Times", fontSize)
 		if !doc.skip_newline {
-			doc.NewLine()
-			doc.SetX(x)
-			doc.skip_newline = false
+			doc.startBlock(x)
 		}
+		doc.skip_newline = false
 
 	case "strong":
 		doc.SetDocFont("TimesBold", fontSize)
 		doc.SetColor(&SAVVA_DARK_COLOR)
 	case "em":
 		doc.SetDocFont("TimesBold", fontSize)
+	case "del", "s":
+		doc.strike++
+	case "code":
+		doc.SetDocFont("Mono", fontSize*0.85)
+	case "pre":
+		doc.codeBlock(n, x, w, fontSize)
+		block.skip = true
+	case "table":
+		doc.mdTable(n, x, w, fontSize)
+		block.skip = true
+	case "blockquote":
+		block.x, block.w = x+doc.indentWidth, w-doc.indentWidth
+		doc.SetColor(&Color{0x55, 0x55, 0x55})
+		block.top = doc.GetY() + fontSize*0.3
+	case "hr":
+		x = doc.startBlock(x)
+		y := doc.GetY() - fontSize*0.4
+		doc.SetStrokeColor(0xbb, 0xbb, 0xbb)
+		doc.SetLineWidth(0.5)
+		doc.Line(x, y, x+w, y)
 	case "ul", "ol":
 		doc.indent++
+		block.x, block.w = x+doc.indentWidth, w-doc.indentWidth
 	case "li":
-		doc.NewLine()
-		doc.SetX(x)
-		doc.Text("• ")
+		x = doc.startBlock(x)
+		doc.listMarker(n, x, w)
 		doc.skip_newline = true
 	case "br":
-		doc.NewLine()
-		doc.SetX(x)
+		doc.startBlock(x)
 	case "img":
 
 		if strings.HasPrefix(n.Attr[0].Val, "https://www.youtube.com") ||
 			strings.HasPrefix(n.Attr[0].Val, "https://youtube.com") {
-			doc.Text("<<YouTube video>> ")
+			doc.mdText("<<YouTube video>> ", x, w)
 		} else {
 
 			if doc.GetImage != nil {
+				doc.flushLine(false)
 				img, err := doc.GetImage(n.Attr[0].Val)
 
 				if err != nil {
@@ -170,67 +370,233 @@ func (doc *Doc) handleElementStart(n *html.Node, x, fontSize float64) {
 	case "a":
 		doc.SetDocFont("Times", 12.0)
 	}
+
+	return block
 }
 
-func (doc *Doc) handleElementEnd(n *html.Node, x float64) {
+func (doc *Doc) handleElementEnd(n *html.Node, x, w float64, block mdBlock) {
 	// log.Debug().Msgf("> %s", n.Data)
 
 	switch n.Data {
 	case "strong", "em":
 		doc.SetDocFont("Times", 12)
-	case "h1", "h2", "h3", "p":
+	case "del", "s":
+		doc.strike--
+	case "h1", "h2", "h3", "h4", "h5", "h6", "p":
 		doc.NewLine()
 		doc.SetX(x)
+	case "blockquote":
+		doc.flushLine(false)
+		top := block.top
+		if doc.CurentPage != block.page {
+			top = doc.Margins.Top
+		}
+		doc.SetStrokeColor(SAVVA_COLOR.R, SAVVA_COLOR.G, SAVVA_COLOR.B)
+		doc.SetLineWidth(2)
+		bar := doc.mdMirror(x+4, 0)
+		doc.Line(bar, top, bar, doc.GetY()-doc.style.FontSize)
 	case "ul", "ol":
 		doc.indent--
+		doc.skip_newline = false
+		if doc.indent == 0 {
+			doc.NewLine()
+		}
 	}
 
 	doc.restoreStyle()
 }
 
-func (doc *Doc) wrapText(words []string, maxWidth float64) (string, float64, []string) {
-	var line string
-	var lineWidth float64
-	spaceWidth, _ := doc.MeasureTextWidth(" ")
-
-	for i, word := range words {
-		wordWidth, _ := doc.MeasureTextWidth(word)
-
-		if i == 0 && wordWidth > maxWidth {
-			// Word is too long to fit in a single line
-			// Split the word into smaller parts
-
-			wl := len(word)
-			for j := 1; j < wl; j++ {
-				w, _ := doc.MeasureTextWidth(word[0 : wl-j])
-				if w <= maxWidth {
-					line += word[0 : wl-j]
-					words[i] = word[wl-j:]
-					break
+// listMarker writes the bullet or the number of the list item before its box
+func (doc *Doc) listMarker(n *html.Node, x, w float64) {
+	marker := BULLETS[(max(doc.indent, 1)-1)%len(BULLETS)]
+	if n.Parent != nil && n.Parent.Data == "ol" {
+		number := 1
+		for _, a := range n.Parent.Attr {
+			if a.Key == "start" {
+				if start, err := strconv.Atoi(a.Val); err == nil {
+					number = start
 				}
 			}
+		}
+		for s := n.PrevSibling; s != nil; s = s.PrevSibling {
+			if s.Type == html.ElementNode && s.Data == "li" {
+				number++
+			}
+		}
+		marker = doc.Lang().FormatNumber(strconv.Itoa(number)) + "."
+	}
 
-			return line, lineWidth, words[i:]
+	// the space after the marker is written for the text to be copied with it
+	mw, _ := doc.MeasureTextWidth(marker + " ")
+	doc.SetX(x - mw - doc.indentWidth*0.15)
+	doc.mdText(marker+" ", x, w)
+	doc.SetX(x)
+}
+
+// codeBlock writes the preformatted text in the monospaced font on a background with its
+// spaces and lines, the lines too long for the box are wrapped
+func (doc *Doc) codeBlock(n *html.Node, x, w, fontSize float64) {
+	const padding = 6
+
+	doc.flushLine(false)
+	x = doc.startBlock(x)
+	bx := doc.mdMirror(x, w)
+
+	// the code is written from left to right, not hyphenated nor justified
+	paragraphDir := doc.paragraphDir
+	doc.paragraphDir = text.DIRECTION_LTR
+	defer func() { doc.paragraphDir = paragraphDir }()
+	doc.style.Hyphenate = false
+
+	size := fontSize * 0.85
+	lineHeight := size * 1.4
+	doc.SetDocFont("Mono", size)
+	doc.SetColor(&Color{0x20, 0x20, 0x20})
+
+	code := strings.ReplaceAll(strings.TrimRight(nodeText(n), "\n"), "\t", "    ")
+	var lines []string
+	for _, line := range strings.Split(code, "\n") {
+		if wrapped := doc.breakLines(line, w-2*padding); len(wrapped) > 0 {
+			lines = append(lines, wrapped...)
+		} else {
+			lines = append(lines, "")
 		}
+	}
 
-		// Include space before word if not first word in the line
-		additionalWidth := wordWidth
-		if i > 0 {
-			additionalWidth += spaceWidth
+	y := doc.GetY() - fontSize*0.7
+	for i, line := range lines {
+		var top, bottom float64 // the padding of the first and the last lines
+		if i == 0 {
+			top = padding
+		}
+		if i == len(lines)-1 {
+			bottom = padding
 		}
 
-		if lineWidth+additionalWidth > maxWidth {
-			return line, lineWidth, words[i:]
+		if y+top+lineHeight+bottom > doc.md.bottom {
+			if !doc.md.autoPage {
+				break
+			}
+			dx := doc.mdNextPage()
+			x, bx = x+dx, bx+dx
+			y = doc.GetY() - fontSize*0.7
+			top = padding
 		}
 
-		if line == "" {
-			line = word
-		} else {
-			line += " " + word
+		doc.SetFillColor(0xf3, 0xf3, 0xf3)
+		doc.Rectangle(bx, y, bx+w, y+top+lineHeight+bottom, "F", 0, 0)
+		doc.SetXY(bx+padding, y+top+size)
+		doc.Text(line)
+		y += top + lineHeight + bottom
+	}
+
+	doc.SetXY(x, y+fontSize)
+}
+
+// TABLE_ALIGNS are the alignments of the columns of the Markdown tables by the align attribute
+var TABLE_ALIGNS = map[string]uint8{"left": 'L', "center": 'C', "right": 'R'}
+
+// mdTable writes the Markdown table as the Table in the box, the widths of the columns follow
+// their content and the alignments are those of the column spec of the Markdown
+func (doc *Doc) mdTable(n *html.Node, x, w, fontSize float64) {
+	var header []string
+	var rows [][]string
+	aligns := make(map[int]uint8)
+
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == "tr" {
+			var cells []string
+			for c := n.FirstChild; c != nil; c = c.NextSibling {
+				if c.Type != html.ElementNode || c.Data != "th" && c.Data != "td" {
+					continue
+				}
+				for _, a := range c.Attr {
+					if align, ok := TABLE_ALIGNS[a.Val]; ok && a.Key == "align" {
+						aligns[len(cells)] = align
+					}
+				}
+				cells = append(cells, strings.TrimSpace(collapseSpaces(nodeText(c))))
+			}
+			if n.Parent != nil && n.Parent.Data == "thead" && header == nil {
+				header = cells
+			} else {
+				rows = append(rows, cells)
+			}
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+
+	columns := len(header)
+	for _, row := range rows {
+		columns = max(columns, len(row))
+	}
+	if columns == 0 || len(rows) == 0 && header == nil {
+		return
+	}
+
+	t := doc.NewTable()
+	t.CellStyle.FontSize = fontSize
+	t.SetW(columns)
+	for j, align := range aligns {
+		t.ColStyle[j].Align = align
+	}
+
+	// the missing cells of the short rows are empty
+	fill := func(cells []string) []string {
+		return append(cells, make([]string, columns-len(cells))...)[:columns]
+	}
+	if header != nil {
+		t.SetHeader(fill(header)...)
+	}
+	for _, row := range rows {
+		t.AddRow(fill(row)...)
+	}
+
+	doc.flushLine(false)
+
+	// the columns get the width of the box by their widest texts, the narrow ones half
+	// of the even width at least
+	doc.saveStyle()
+	var total float64
+	for j := range t.ColWidths {
+		style := &t.ColStyle[j]
+		doc.SetDocFont(style.FontName, style.FontSize)
+		for _, row := range t.Cells {
+			tw, _ := doc.MeasureTextWidth(row[j])
+			t.ColWidths[j] = max(t.ColWidths[j], tw+style.Padding.Left+style.Padding.Right)
+		}
+		if t.Header != nil {
+			style = &doc.TableHeaderStyle
+			doc.SetDocFont(style.FontName, style.FontSize)
+			tw, _ := doc.MeasureTextWidth(t.Header[j])
+			t.ColWidths[j] = max(t.ColWidths[j], tw+style.Padding.Left+style.Padding.Right)
 		}
+		t.ColWidths[j] = max(t.ColWidths[j], w/float64(columns)/2)
+		total += t.ColWidths[j]
+	}
+	doc.restoreStyle()
+	for j := range t.ColWidths {
+		t.ColWidths[j] *= w / total
+	}
 
-		lineWidth += additionalWidth
+	if doc.GetX() > x {
+		doc.NewLine()
 	}
 
-	return line, lineWidth, nil
+	// at the box, not centered on the page, and mirrored in it as the text. The box
+	// follows the margins on the next pages.
+	page, left := doc.CurentPage, doc.Margins.Left
+	y := doc.writeTable(t, x, func(x, w float64) float64 {
+		dx := doc.Margins.Left - left
+		return doc.mdMirror(x-dx, w) + dx
+	})
+	if doc.CurentPage != page {
+		x += doc.mdFollowPage(left)
+	}
+	doc.SetXY(x, y)
 }
@@ -59,11 +59,10 @@ func (doc *Doc) NewSubSection(title string) {
 	doc.SubSection = len(doc.Sections[doc.Section].SubSections) - 1
 	doc.SubSubSection = 0
 
-	doc.SetX(doc.Margins.Left)
 	doc.SetFont("DejaVuBold", "", 18)
 	doc.SetTextColor(0, 0, 0) // Black
 
-	doc.Text(title)
+	doc.textAtStart(title, 0)
 
 	// Draw a line under the title
 	doc.SetLineWidth(1)
@@ -90,11 +89,10 @@ func (doc *Doc) NewSubSubSection(title string) {
 	doc.Sections[doc.Section].SubSections[doc.SubSection].SubSections = append(doc.Sections[doc.Section].SubSections[doc.SubSection].SubSections, &Section{Title: title, Page: doc.CurentPage})
 	doc.SubSubSection = len(doc.Sections[doc.Section].SubSections[doc.SubSection].SubSections) - 1
 
-	doc.SetX(doc.Margins.Left + 20)
 	doc.SetFont("TimesBold", "", 16)
 	doc.SetTextColor(0, 0, 0) // Black
 
-	doc.Text(title)
+	doc.textAtStart(title, 20)
 
 	// Draw a line under the title
 	doc.SetLineWidth(1)
@@ -105,3 +103,14 @@ func (doc *Doc) NewSubSubSection(title string) {
 	doc.SetY(doc.GetY() + 30)
 	doc.SetX(doc.Margins.Left)
 }
+
+// textAtStart writes the title at the indent from the left margin, or from the
+// right one in the right-to-left reports
+func (doc *Doc) textAtStart(title string, indent float64) {
+	if doc.RTL() {
+		doc.TextRight(title, doc.PageWidth-doc.Margins.Right-indent, doc.GetY())
+		return
+	}
+	doc.SetX(doc.Margins.Left + indent)
+	doc.Text(title)
+}
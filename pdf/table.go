@@ -12,30 +12,51 @@ type Table struct {
 	W, H             int
 	ColWidths        []float64
 	ColStyle         []Style
+	CellStyle        Style // default style of the new columns
+	GroupStyle       Style // style of the group rows
 	OnBeforeDrawCell func(t *Table, row, col int, x, y float64, w float64, h float64, text string, style *Style)
+
+	groups map[int]bool // rows spanning all the columns
+}
+
+func DefaultTableHeaderStyle() Style {
+	return Style{
+		FontName:  "DejaVuBold",
+		FontSize:  12,
+		FontColor: &Color{0xf7, 0xf7, 0xf7},
+		BGColor:   &Color{SAVVA_DARK_COLOR.R, SAVVA_DARK_COLOR.G, SAVVA_DARK_COLOR.B},
+		Align:     'C',
+		Padding:   PaddingDescription{Left: 5, Top: 4, Right: 5, Bottom: 6},
+	}
 }
 
-var HeaderStyle = &Style{
-	FontName:  "DejaVuBold",
-	FontSize:  12,
-	FontColor: &Color{0xf7, 0xf7, 0xf7},
-	BGColor:   &SAVVA_DARK_COLOR,
-	Align:     'C',
-	Padding:   PaddingDescription{Left: 5, Top: 4, Right: 5, Bottom: 6},
+func DefaultTableCellStyle() Style {
+	return Style{
+		FontName:  "Arial",
+		FontSize:  12,
+		FontColor: &Color{0, 0, 0},
+		Padding:   PaddingDescription{Left: 5, Top: 4, Right: 0, Bottom: 6},
+		Align:     'L',
+	}
 }
 
-var DefaultStyle = &Style{
-	FontName:  "Arial",
-	FontSize:  12,
-	FontColor: &Color{0, 0, 0},
-	Padding:   PaddingDescription{Left: 5, Top: 4, Right: 0, Bottom: 6},
-	Align:     'L',
+func DefaultTableGroupStyle() Style {
+	return Style{
+		FontName:  "DejaVuBold",
+		FontSize:  11,
+		FontColor: &Color{0, 0, 0},
+		BGColor:   &Color{0xfc, 0xe6, 0xcf},
+		Padding:   PaddingDescription{Left: 5, Top: 3, Right: 5, Bottom: 5},
+		Align:     'L',
+	}
 }
 
-func NewTable() *Table {
+func (doc *Doc) NewTable() *Table {
 	t := &Table{
-		W: 0,
-		H: 0,
+		W:          0,
+		H:          0,
+		CellStyle:  doc.TableCellStyle,
+		GroupStyle: doc.TableGroupStyle,
 	}
 	return t
 }
@@ -50,7 +71,7 @@ func (t *Table) SetW(w int) {
 	if len(t.ColStyle) != w {
 		t.ColStyle = make([]Style, w)
 		for i := 0; i < w; i++ {
-			t.ColStyle[i] = *DefaultStyle
+			t.ColStyle[i] = t.CellStyle
 		}
 	}
 }
@@ -81,15 +102,46 @@ func (t *Table) AddRow(s ...string) {
 	t.H++
 }
 
-func (doc *Doc) WriteTable(t *Table) {
-	if t.W == 0 || t.H == 0 {
-		log.Error().Msg("Invalid table: no columns or rows")
+// AddGroupRow adds a row that spans all the columns, e.g. the heading of the following rows.
+// The table must have the columns already.
+func (t *Table) AddGroupRow(text string) {
+	if t.W == 0 {
+		log.Error().Msg("Group row added to a table without columns")
 		return
 	}
 
+	if t.groups == nil {
+		t.groups = make(map[int]bool)
+	}
+	t.groups[len(t.Cells)] = true
+
+	row := make([]string, t.W)
+	row[0] = text
+	t.AddRow(row...)
+}
+
+func (t *Table) IsGroupRow(row int) bool {
+	return t.groups[row]
+}
+
+func (t *Table) totalWidth() float64 {
+	w := 0.
+	for _, cw := range t.ColWidths {
+		w += cw
+	}
+	return w
+}
+
+// WriteTable draws the table centered on the page
+func (doc *Doc) WriteTable(t *Table) {
+	doc.writeTable(t, doc.centerTable(t), doc.MirrorX)
+}
+
+// centerTable spreads the columns of zero width over the width between the margins and
+// returns the x of the table centered on the page
+func (doc *Doc) centerTable(t *Table) float64 {
 	n_zero_width_columns := 0
 	total_width := 0.
-	table_x := doc.Margins.Left
 	for i := 0; i < t.W; i++ {
 		total_width += t.ColWidths[i]
 		if t.ColWidths[i] == 0 {
@@ -97,18 +149,36 @@ func (doc *Doc) WriteTable(t *Table) {
 		}
 	}
 
-	if n_zero_width_columns > 0 {
-		// use all page width for the table
-		// and spread 0 width columns evenly
-		for i := 0; i < t.W; i++ {
-			if t.ColWidths[i] == 0 {
-				t.ColWidths[i] = (doc.GetMarginWidth() - total_width) / float64(n_zero_width_columns)
-			}
+	if n_zero_width_columns == 0 {
+		return doc.Margins.Left + (doc.GetMarginWidth()-total_width)/2
+	}
+
+	// use all page width for the table
+	// and spread 0 width columns evenly
+	for i := 0; i < t.W; i++ {
+		if t.ColWidths[i] == 0 {
+			t.ColWidths[i] = (doc.GetMarginWidth() - total_width) / float64(n_zero_width_columns)
 		}
-		total_width = doc.GetMarginWidth()
-	} else {
-		table_x = doc.Margins.Left + (doc.GetMarginWidth()-total_width)/2
 	}
+	return doc.Margins.Left
+}
+
+// writeTable draws the table at table_x from the current y, on the next pages if it does not
+// fit, and returns the y below it. table_x is laid out from the left margin and keeps its
+// distance from the margin on the next pages. mirror returns the x of the box at x, w in the
+// right-to-left layout of the current page, e.g. MirrorX.
+func (doc *Doc) writeTable(t *Table, table_x float64, mirror func(x, w float64) float64) float64 {
+	if t.W == 0 || t.H == 0 {
+		log.Error().Msg("Invalid table: no columns or rows")
+		return doc.GetY()
+	}
+
+	total_width := t.totalWidth()
+
+	// band_x returns the left side of the rows, mirrored with the columns in the right-to-left reports
+	band_x := func() float64 { return mirror(table_x, total_width) }
+
+	header_style := &doc.TableHeaderStyle
 
 	table_y := doc.GetY()
 	y := table_y
@@ -123,22 +193,24 @@ func (doc *Doc) WriteTable(t *Table) {
 	}
 
 	if header_height > 0 { // Write header
-		if HeaderStyle.BGColor != nil {
-			doc.SetFillColor(HeaderStyle.BGColor.R, HeaderStyle.BGColor.G, HeaderStyle.BGColor.B)
-			doc.SetStrokeColor(HeaderStyle.BGColor.R, HeaderStyle.BGColor.G, HeaderStyle.BGColor.B)
+		if header_style.BGColor != nil {
+			doc.SetFillColor(header_style.BGColor.R, header_style.BGColor.G, header_style.BGColor.B)
+			doc.SetStrokeColor(header_style.BGColor.R, header_style.BGColor.G, header_style.BGColor.B)
 			doc.SetLineWidth(0.5)
-			doc.Rectangle(table_x, y, table_x+total_width, y+header_height, "DF", 0, 0)
+			doc.Rectangle(band_x(), y, band_x()+total_width, y+header_height, "DF", 0, 0)
 		}
 
 		x := table_x
 		for j, text := range t.Header {
-			doc.writeTextInWidth(text, x, y, t.ColWidths[j], HeaderStyle)
+			doc.writeTextInWidth(text, mirror(x, t.ColWidths[j]), y, t.ColWidths[j], header_style)
 			x += t.ColWidths[j]
 		}
 
 		y += header_height
 	}
 
+	group := -1 // the last group row
+	data_row := 0
 	for i, row := range t.Cells {
 
 		// doc.SetStrokeColor(255, 0, 0) //DEBUG
@@ -146,20 +218,26 @@ func (doc *Doc) WriteTable(t *Table) {
 
 		row_height := doc.estimateRowHeight(t, i)
 
-		if y+row_height > doc.PageHeight-doc.Margins.Bottom {
+		// keep the group row on the same page with its first row
+		keep_height := row_height
+		if t.IsGroupRow(i) {
+			keep_height += doc.estimateRowHeight(t, i+1)
+		}
+
+		if y+keep_height > doc.PageHeight-doc.Margins.Bottom {
 			// may be add later ..continue to next page
 
 			// Draw the border around the table
-			doc.SetStrokeColor(HeaderStyle.BGColor.R, HeaderStyle.BGColor.G, HeaderStyle.BGColor.B)
+			doc.SetStrokeColor(header_style.BGColor.R, header_style.BGColor.G, header_style.BGColor.B)
 			doc.SetLineWidth(0.5)
 			// vertical lines
 			var v_x = table_x
 			for i := 0; i < t.W; i++ {
-				doc.Line(v_x, table_y, v_x, y)
+				doc.Line(mirror(v_x, 0), table_y, mirror(v_x, 0), y)
 				v_x += t.ColWidths[i]
 			}
-			doc.Line(v_x, table_y, v_x, y)               // right
-			doc.Line(table_x, y, table_x+total_width, y) // bottom
+			doc.Line(mirror(v_x, 0), table_y, mirror(v_x, 0), y) // right
+			doc.Line(band_x(), y, band_x()+total_width, y)       // bottom
 
 			table_x -= doc.Margins.Left
 			doc.NextPage()
@@ -168,47 +246,60 @@ func (doc *Doc) WriteTable(t *Table) {
 
 			y = table_y
 			if header_height > 0 { // Write header
-				if HeaderStyle.BGColor != nil {
-					doc.SetFillColor(HeaderStyle.BGColor.R, HeaderStyle.BGColor.G, HeaderStyle.BGColor.B)
-					doc.SetStrokeColor(HeaderStyle.BGColor.R, HeaderStyle.BGColor.G, HeaderStyle.BGColor.B)
+				if header_style.BGColor != nil {
+					doc.SetFillColor(header_style.BGColor.R, header_style.BGColor.G, header_style.BGColor.B)
+					doc.SetStrokeColor(header_style.BGColor.R, header_style.BGColor.G, header_style.BGColor.B)
 					doc.SetLineWidth(0.5)
-					doc.Rectangle(table_x, y, table_x+total_width, y+header_height, "DF", 0, 0)
+					doc.Rectangle(band_x(), y, band_x()+total_width, y+header_height, "DF", 0, 0)
 				}
 
 				x := table_x
 				for j, text := range t.Header {
-					doc.writeTextInWidth(text, x, y, t.ColWidths[j], HeaderStyle)
+					doc.writeTextInWidth(text, mirror(x, t.ColWidths[j]), y, t.ColWidths[j], header_style)
 					x += t.ColWidths[j]
 				}
 				y += header_height
 			}
+
+			// repeat the heading of the group that continues on the new page
+			if group >= 0 && !t.IsGroupRow(i) {
+				y = doc.writeGroupRow(t, group, band_x(), y, total_width)
+			}
+		}
+
+		if t.IsGroupRow(i) {
+			group = i
+			y = doc.writeGroupRow(t, i, band_x(), y, total_width)
+			continue
 		}
 
-		if i&1 == 1 {
-			// make grey background for even rows
+		if data_row&1 == 1 {
+			// make grey background for even rows, the group rows are not counted
 			doc.SetFillColor(0xf5, 0xf5, 0xf5)
 			doc.SetStrokeColor(0xf5, 0xf5, 0xf5)
 			doc.SetLineWidth(0.5)
-			doc.Rectangle(table_x, y, table_x+total_width, y+row_height, "DF", 0, 0)
+			doc.Rectangle(band_x(), y, band_x()+total_width, y+row_height, "DF", 0, 0)
 		}
+		data_row++
 
 		x := table_x
 		for j, text := range row {
+			cell_x := mirror(x, t.ColWidths[j]) // the columns go from the right in the right-to-left reports
 
 			if t.OnBeforeDrawCell != nil {
-				t.OnBeforeDrawCell(t, i, j, x, y, t.ColWidths[j], row_height, text, &t.ColStyle[j])
+				t.OnBeforeDrawCell(t, i, j, cell_x, y, t.ColWidths[j], row_height, text, &t.ColStyle[j])
 			}
 
 			if strings.HasPrefix(text, "!MD") {
 				doc.SetDocFont(t.ColStyle[j].FontName, t.ColStyle[j].FontSize)
 				doc.MarkDownToPdfEx(
-					strings.TrimPrefix(text, "!MD"), x+t.ColStyle[j].Padding.Left,
+					strings.TrimPrefix(text, "!MD"), cell_x+t.ColStyle[j].Padding.Left,
 					y+t.ColStyle[j].Padding.Top,
 					t.ColWidths[j]-t.ColStyle[j].Padding.Left-t.ColStyle[j].Padding.Right,
 					row_height-t.ColStyle[j].Padding.Top-t.ColStyle[j].Padding.Bottom,
 					false)
 			} else {
-				doc.writeTextInWidth(text, x, y, t.ColWidths[j], &t.ColStyle[j])
+				doc.writeTextInWidth(text, cell_x, y, t.ColWidths[j], &t.ColStyle[j])
 			}
 			x += t.ColWidths[j]
 		}
@@ -217,17 +308,33 @@ func (doc *Doc) WriteTable(t *Table) {
 	}
 
 	// Draw the border around the table
-	doc.SetStrokeColor(HeaderStyle.BGColor.R, HeaderStyle.BGColor.G, HeaderStyle.BGColor.B)
+	doc.SetStrokeColor(header_style.BGColor.R, header_style.BGColor.G, header_style.BGColor.B)
 	doc.SetLineWidth(0.5)
 	// vertical lines
 	var v_x = table_x
 	for i := 0; i < t.W; i++ {
-		doc.Line(v_x, table_y, v_x, y)
+		doc.Line(mirror(v_x, 0), table_y, mirror(v_x, 0), y)
 		v_x += t.ColWidths[i]
 	}
-	doc.Line(v_x, table_y, v_x, y)               // right
-	doc.Line(table_x, y, table_x+total_width, y) // bottom
+	doc.Line(mirror(v_x, 0), table_y, mirror(v_x, 0), y) // right
+	doc.Line(band_x(), y, band_x()+total_width, y)       // bottom
 
+	return y
+}
+
+// writeGroupRow draws the group row over the whole width of the table and returns the y below it
+func (doc *Doc) writeGroupRow(t *Table, row int, x, y, w float64) float64 {
+	h := doc.estimateRowHeight(t, row)
+
+	if t.GroupStyle.BGColor != nil {
+		doc.SetFillColor(t.GroupStyle.BGColor.R, t.GroupStyle.BGColor.G, t.GroupStyle.BGColor.B)
+		doc.SetStrokeColor(t.GroupStyle.BGColor.R, t.GroupStyle.BGColor.G, t.GroupStyle.BGColor.B)
+		doc.SetLineWidth(0.5)
+		doc.Rectangle(x, y, x+w, y+h, "DF", 0, 0)
+	}
+
+	doc.writeTextInWidth(t.Cells[row][0], x, y, w, &t.GroupStyle)
+	return y + h
 }
 
 func (doc *Doc) estimateHeaderHeight(t *Table) float64 {
@@ -236,7 +343,7 @@ func (doc *Doc) estimateHeaderHeight(t *Table) float64 {
 	}
 	header_height := 0.
 	for i, text := range t.Header {
-		header_height = max(header_height, doc.estimateTextHeight(text, t.ColWidths[i], HeaderStyle))
+		header_height = max(header_height, doc.estimateTextHeight(text, t.ColWidths[i], &doc.TableHeaderStyle))
 	}
 
 	return header_height
@@ -247,6 +354,10 @@ func (doc *Doc) estimateRowHeight(t *Table, row int) float64 {
 		return 0
 	}
 
+	if t.IsGroupRow(row) {
+		return doc.estimateTextHeight(t.Cells[row][0], t.totalWidth(), &t.GroupStyle)
+	}
+
 	row_height := 0.
 	for i, text := range t.Cells[row] {
 		row_height = max(row_height, t.ColStyle[i].MinHeight+t.ColStyle[i].Padding.Top+t.ColStyle[i].Padding.Bottom)
@@ -10,6 +10,10 @@ import (
 	"time"
 
 	"github.com/AlexNa-Holdings/savva-reports/cmn"
+	"github.com/AlexNa-Holdings/savva-reports/currency"
+	"github.com/AlexNa-Holdings/savva-reports/i18n"
+	"github.com/AlexNa-Holdings/savva-reports/text"
+	"github.com/rs/zerolog/log"
 	"github.com/signintech/gopdf"
 )
 
@@ -42,7 +46,7 @@ func (doc *Doc) TextWidthStyle(text string, x, y, w float64, style *Style) {
 		doc.SetDocFont(style.FontName, style.FontSize)
 	}
 
-	switch style.Align {
+	switch doc.align(style.Align, text) {
 	case 'L':
 		doc.TextLeft(text, x+style.Padding.Left, y)
 	case 'C':
@@ -61,6 +65,7 @@ func (doc *Doc) AddBlankPage() {
 }
 
 func (doc *Doc) NextPage() {
+	doc.flushLine(false)
 	doc.AddPage()
 	doc.CurentPage++
 
@@ -99,15 +104,40 @@ func (doc *Doc) Header() {
 	}
 }
 func (doc *Doc) Footer() {
-	// print date of generation
-	doc.SetFont("Mono", "", 10)
-	doc.TextCentered(fmt.Sprintf("Generated on: %s",
-		time.Now().UTC().Format(time.RFC822)),
+	// print date of generation, the mono font has no Cyrillic letters for the month names
+	doc.SetFont("Arial", "", 10)
+	doc.TextCentered(doc.Tf("generated_on", i18n.Args{"time": doc.FormatTime(time.Now())}),
 		0,
 		cmn.PageHeight-doc.Margins.Bottom+10)
 }
 
+// LocalTime returns the time in the time zone of the report
+func (doc *Doc) LocalTime(t time.Time) time.Time {
+	return t.In(doc.Location)
+}
+
+// FormatTime writes the date and the time in the time zone of the report, the zone is included
+func (doc *Doc) FormatTime(t time.Time) string {
+	return doc.Lang().FormatDateTime(doc.LocalTime(t))
+}
+
+// FormatDate writes the date in the time zone of the report, e.g. March 2, 2025
+func (doc *Doc) FormatDate(t time.Time) string {
+	return doc.Lang().FormatDate(doc.LocalTime(t))
+}
+
+// FormatShortDate writes the date in the time zone of the report for the tables
+func (doc *Doc) FormatShortDate(t time.Time) string {
+	return doc.Lang().FormatShortDate(doc.LocalTime(t))
+}
+
+// FormatTimeOfDay writes the time of the day in the time zone of the report
+func (doc *Doc) FormatTimeOfDay(t time.Time) string {
+	return doc.Lang().FormatTimeOfDay(doc.LocalTime(t))
+}
+
 func (doc *Doc) NewLine() {
+	doc.flushLine(false)
 	doc.SetY(doc.GetY() + doc.style.FontSize*1.3)
 	doc.SetX(doc.Margins.Left + float64(doc.indent)*doc.indentWidth)
 }
@@ -132,27 +162,7 @@ func (doc *Doc) estimateTextHeight(text string, width float64, style *Style) flo
 	// Calculate line height (standard is ~1.2x font size)
 	lineHeight := style.FontSize * 1.2
 
-	words := strings.Fields(text)
-	var currentWidth float64
-	var lines int = 1
-
-	spaceWidth, _ := doc.MeasureTextWidth(" ")
-
-	for i, word := range words {
-		wordWidth, _ := doc.MeasureTextWidth(word)
-
-		// Add space width if it's not the first word
-		if i > 0 {
-			wordWidth += spaceWidth
-		}
-
-		if currentWidth+wordWidth > float64(width) {
-			lines++
-			currentWidth = wordWidth - spaceWidth // Reset current line width to current word's width
-		} else {
-			currentWidth += wordWidth
-		}
-	}
+	lines := max(len(doc.breakLines(text, width)), 1)
 
 	return lineHeight*float64(lines) + style.Padding.Top + style.Padding.Bottom
 }
@@ -168,6 +178,11 @@ func (doc *Doc) writeTextInWidth(text string, x, y, width float64, style *Style)
 	doc.saveStyle()
 	defer doc.restoreStyle()
 
+	// all the lines have the direction of the text
+	paragraphDir := doc.paragraphDir
+	doc.paragraphDir = doc.direction(text)
+	defer func() { doc.paragraphDir = paragraphDir }()
+
 	width -= style.Padding.Left + style.Padding.Right
 
 	// Set desired style for text
@@ -178,41 +193,14 @@ func (doc *Doc) writeTextInWidth(text string, x, y, width float64, style *Style)
 	y += text_height + style.Padding.Top
 
 	lineHeight := style.FontSize * 1.2
-	spaceWidth, _ := doc.MeasureTextWidth(" ")
 
-	words := strings.Fields(text)
-	if len(words) == 0 {
+	if strings.TrimFunc(text, isBreakSpace) == "" {
 		doc.NewLine()
 		return
 	}
 
-	var line string
-	var lineWidth float64
-
-	for _, word := range words {
-		wordWidth, _ := doc.MeasureTextWidth(word)
-
-		// Add space width if line isn't empty
-		additionalWidth := wordWidth
-		if line != "" {
-			additionalWidth += spaceWidth
-		}
-
-		if lineWidth+additionalWidth > float64(width) {
-			switch style.Align {
-			case 'L':
-				doc.TextLeft(line, x+style.Padding.Left, y)
-			case 'C':
-				doc.TextCentered(line, x+style.Padding.Left+width/2, y)
-			case 'R':
-				doc.TextRight(line, x+width-style.Padding.Right, y)
-			default:
-				doc.TextLeft(line, x+style.Padding.Left, y)
-			}
-
-			line = word
-			lineWidth = wordWidth
-
+	for i, line := range doc.breakLines(text, width) {
+		if i > 0 {
 			// Move to next line
 			y += lineHeight
 
@@ -220,18 +208,9 @@ func (doc *Doc) writeTextInWidth(text string, x, y, width float64, style *Style)
 			if doc.GetY() > doc.PageHeight-doc.Margins.Bottom {
 				doc.NextPage()
 			}
-		} else {
-			if line != "" {
-				line += " "
-			}
-			line += word
-			lineWidth += additionalWidth
 		}
-	}
 
-	// Write the last remaining line
-	if line != "" {
-		switch style.Align {
+		switch doc.align(style.Align, text) {
 		case 'L':
 			doc.TextLeft(line, x+style.Padding.Left, y)
 		case 'C':
@@ -264,10 +243,15 @@ func Value2Float(v *big.Int, decimals int) float64 {
 	return value
 }
 
-func FormatValue(amount *big.Int, decimals int) string {
-	var precision = 2
+// decimalValue writes the amount with the decimals as a plain decimal number cut to the precision
+func decimalValue(amount *big.Int, decimals, precision int) string {
 	str := amount.String()
 
+	sign := ""
+	if strings.HasPrefix(str, "-") {
+		sign, str = "-", str[1:]
+	}
+
 	if len(str) <= decimals {
 		str = strings.Repeat("0", decimals-len(str)+1) + str
 	}
@@ -279,76 +263,100 @@ func FormatValue(amount *big.Int, decimals int) string {
 	} else if len(decimal) < precision {
 		decimal = decimal + strings.Repeat("0", precision-len(decimal))
 	}
-	str = str[:len(str)-decimals] + "." + decimal
-
-	// // Trim trailing zeros and the decimal point if necessary
-	// str = strings.TrimRight(str, "0")
-	// str = strings.TrimRight(str, ".")
-
-	// Add commas to the integer part
-	parts := strings.Split(str, ".")
-	intPart := parts[0]
-	fracPart := ""
-	if len(parts) > 1 {
-		fracPart = parts[1]
-	}
 
-	intPartWithCommas := ""
-	n := len(intPart)
-	for i, ch := range intPart {
-		if i > 0 && (n-i)%3 == 0 && intPart[i-1] != '-' {
-			intPartWithCommas += ","
-		}
-		intPartWithCommas += string(ch)
-	}
+	return sign + str[:len(str)-decimals] + "." + decimal
+}
 
-	if fracPart != "" {
-		return intPartWithCommas + "." + fracPart
-	}
-	return intPartWithCommas
+// FormatValue writes the amount in English, e.g. 1,234.56
+func FormatValue(amount *big.Int, decimals int) string {
+	return i18n.Default().Get("en").FormatNumber(decimalValue(amount, decimals, 2))
 }
 
-// addCommas adds commas to a numeric string.
-func addCommas(number string) string {
-	var result strings.Builder
-	n := len(number)
+// FormatValue writes the amount with two decimals in the language of the report
+func (doc *Doc) FormatValue(amount *big.Int, decimals int) string {
+	return doc.Lang().FormatNumber(decimalValue(amount, decimals, 2))
+}
 
-	for i, digit := range number {
-		if (n-i)%3 == 0 && i != 0 && number[i-1] != '-' {
-			result.WriteRune(',')
-		}
-		result.WriteRune(digit)
+// PriceAt returns the token price in the report currency at the time t. If the price or
+// the exchange rate is unknown the report must not be written, see PriceError.
+func (doc *Doc) PriceAt(t time.Time) float64 {
+	if p, ok := doc.prices[t.Unix()]; ok {
+		return p
 	}
 
-	return result.String()
-}
+	p, err := doc.Config.Price.PriceAt(t)
+	if err != nil {
+		err = fmt.Errorf("no token price at %s: %w", t.Format(time.RFC3339), err)
+	} else if doc.Currency.Code != currency.USD {
+		var rate float64
+		rate, err = doc.Config.FX.RateAt(doc.Currency.Code, t)
+		if err != nil {
+			err = fmt.Errorf("no %s rate at %s: %w", doc.Currency.Code, t.Format(time.RFC3339), err)
+		}
+		p *= rate
+	}
 
-func (doc *Doc) FormatValue(amount *big.Int, decimals int) string {
-	str := FormatValue(amount, decimals)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to value the report")
+		if doc.priceErr == nil {
+			doc.priceErr = err
+		}
+	}
 
-	if doc.Locale == "ru" {
-		//replace . -> ' ', . -> ,
-		str = strings.ReplaceAll(str, ",", " ")
-		str = strings.ReplaceAll(str, ".", ",")
+	if doc.prices == nil {
+		doc.prices = make(map[int64]float64)
 	}
+	doc.prices[t.Unix()] = p
+	return p
+}
 
-	return str
+// FiatAt returns the fiat value of the amount at the time t
+func (doc *Doc) FiatAt(amount *big.Int, t time.Time) float64 {
+	return Value2Float(amount, 18) * doc.PriceAt(t)
 }
 
 func (doc *Doc) FormatFiat(value float64) string {
-	str := fmt.Sprintf("%.2f", value)
+	return doc.FormatFiatN(value, doc.Currency.Decimals)
+}
+
+// FormatPrice formats the token price, which is usually a small fraction of the currency unit
+func (doc *Doc) FormatPrice(value float64) string {
+	return doc.FormatFiatN(value, doc.Currency.Decimals+4)
+}
 
-	p := strings.Split(str, ".")
+func (doc *Doc) FormatFiatN(value float64, decimals int) string {
+	return doc.Lang().FormatCurrency(value, decimals, doc.Currency.Symbol)
+}
 
-	str = addCommas(p[0]) + "." + p[1]
+// FormatPercent writes the percentage, 25 is 25%
+func (doc *Doc) FormatPercent(value float64, decimals int) string {
+	return doc.Lang().FormatPercent(value, decimals)
+}
 
-	if doc.Locale == "ru" {
-		//replace . -> ' ', . -> ,
-		str = strings.ReplaceAll(str, ",", " ")
-		str = strings.ReplaceAll(str, ".", ",")
+// WarningBox draws the text in a red frame over the whole width of the margins
+func (doc *Doc) WarningBox(text string) {
+	style := Style{
+		FontName:  "DejaVuBold",
+		FontSize:  11,
+		FontColor: &WARNING_COLOR,
+		BGColor:   &Color{0xfd, 0xe8, 0xe8},
+		Padding:   PaddingDescription{Left: 8, Top: 6, Right: 8, Bottom: 8},
+		Align:     'L',
 	}
 
-	return cmn.C.CurrencySymbol + str
+	x, w := doc.Margins.Left, doc.GetMarginWidth()
+	h := doc.estimateTextHeight(text, w, &style)
+	doc.AssureVertialSpace(h)
+	y := doc.GetY()
+
+	doc.SetFillColor(style.BGColor.R, style.BGColor.G, style.BGColor.B)
+	doc.SetStrokeColor(WARNING_COLOR.R, WARNING_COLOR.G, WARNING_COLOR.B)
+	doc.SetLineWidth(1.5)
+	doc.Rectangle(x, y, x+w, y+h, "DF", 0, 0)
+
+	doc.writeTextInWidth(text, x, y, w, &style)
+	doc.SetXY(doc.Margins.Left, y+h)
+	doc.NewLine()
 }
 
 // DrawImageCover crops and scales the image to cover the given area.
@@ -438,17 +446,19 @@ func (doc *Doc) EclipseToWidth(s string, width float64) (string, float64) {
 		return s, textWidth
 	}
 
-	// If it doesn't fit, truncate and add ellipsis
+	// If it doesn't fit, truncate between the graphemes and add ellipsis
 	ellipsis := "..."
-	truncated := s
+	breaks := text.GraphemeBreaks(s)
+	truncated := ""
 
-	for len(truncated) > 0 {
-		truncated = truncated[:len(truncated)-1]
+	for i := len(breaks) - 2; i >= 0; i-- {
+		truncated = trimBreak(s[:breaks[i]])
 		textWidth, _ = doc.MeasureTextWidth(truncated + ellipsis)
 		if textWidth <= width {
-			break
+			return truncated + ellipsis, textWidth
 		}
 	}
 
-	return truncated + ellipsis, textWidth
+	textWidth, _ = doc.MeasureTextWidth(ellipsis)
+	return ellipsis, textWidth
 }
@@ -0,0 +1,203 @@
+package period
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	KIND_MONTH   = "month"
+	KIND_QUARTER = "quarter"
+	KIND_YEAR    = "year"
+	KIND_CUSTOM  = "custom"
+)
+
+const (
+	MIN_YEAR = 2000
+	MAX_YEAR = 9999
+)
+
+// FORMATS describes the accepted period strings
+const FORMATS = "YYYY-MM, YYYY-Qn, YYYY or YYYY-MM-DD..YYYY-MM-DD"
+
+const dateFormat = "2006-01-02"
+
+// Period is the time range of a report. From is inclusive, To is exclusive.
+// The constructors return the periods in UTC, see In for the other time zones.
+type Period struct {
+	Kind     string
+	From, To time.Time
+}
+
+func Month(year, month int) Period {
+	from := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	return Period{Kind: KIND_MONTH, From: from, To: from.AddDate(0, 1, 0)}
+}
+
+// Quarter returns the quarter 1-4 of the year
+func Quarter(year, quarter int) Period {
+	from := time.Date(year, time.Month(3*(quarter-1)+1), 1, 0, 0, 0, 0, time.UTC)
+	return Period{Kind: KIND_QUARTER, From: from, To: from.AddDate(0, 3, 0)}
+}
+
+func Year(year int) Period {
+	from := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	return Period{Kind: KIND_YEAR, From: from, To: from.AddDate(1, 0, 0)}
+}
+
+// Custom returns the period from the first to the last day, both included
+func Custom(first, last time.Time) (Period, error) {
+	from := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, time.UTC)
+	to := time.Date(last.Year(), last.Month(), last.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+
+	if !from.Before(to) {
+		return Period{}, fmt.Errorf("the period ends before it starts: %s..%s", first.Format(dateFormat), last.Format(dateFormat))
+	}
+	return Period{Kind: KIND_CUSTOM, From: from, To: to}, nil
+}
+
+var (
+	reYear    = regexp.MustCompile(`^(\d{4})$`)
+	reQuarter = regexp.MustCompile(`^(\d{4})-[Qq]([1-4])$`)
+	reMonth   = regexp.MustCompile(`^(\d{4})-(\d{2})$`)
+)
+
+// Parse parses the period in one of the FORMATS
+func Parse(s string) (Period, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Period{}, fmt.Errorf("period is not set")
+	}
+
+	if first, last, ok := strings.Cut(s, ".."); ok {
+		f, err := time.Parse(dateFormat, first)
+		if err != nil {
+			return Period{}, fmt.Errorf("invalid period start %q, expected YYYY-MM-DD", first)
+		}
+
+		l, err := time.Parse(dateFormat, last)
+		if err != nil {
+			return Period{}, fmt.Errorf("invalid period end %q, expected YYYY-MM-DD", last)
+		}
+
+		if err := checkYear(f.Year()); err != nil {
+			return Period{}, err
+		}
+		if err := checkYear(l.Year()); err != nil {
+			return Period{}, err
+		}
+		return Custom(f, l)
+	}
+
+	var p Period
+	switch {
+	case reYear.MatchString(s):
+		year, _ := strconv.Atoi(s)
+		p = Year(year)
+	case reQuarter.MatchString(s):
+		m := reQuarter.FindStringSubmatch(s)
+		year, _ := strconv.Atoi(m[1])
+		quarter, _ := strconv.Atoi(m[2])
+		p = Quarter(year, quarter)
+	case reMonth.MatchString(s):
+		m := reMonth.FindStringSubmatch(s)
+		year, _ := strconv.Atoi(m[1])
+		month, _ := strconv.Atoi(m[2])
+		if month < 1 || month > 12 {
+			return Period{}, fmt.Errorf("invalid month in period %q", s)
+		}
+		p = Month(year, month)
+	default:
+		return Period{}, fmt.Errorf("invalid period %q, expected %s", s, FORMATS)
+	}
+
+	if err := checkYear(p.From.Year()); err != nil {
+		return Period{}, err
+	}
+	return p, nil
+}
+
+func checkYear(year int) error {
+	if year < MIN_YEAR || year > MAX_YEAR {
+		return fmt.Errorf("invalid year %d", year)
+	}
+	return nil
+}
+
+// In returns the period with the same calendar days in the location
+func (p Period) In(loc *time.Location) Period {
+	day := func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	}
+	return Period{Kind: p.Kind, From: day(p.From), To: day(p.To)}
+}
+
+// Quarter returns the quarter 1-4 of the start of the period
+func (p Period) Quarter() int {
+	return (int(p.From.Month())-1)/3 + 1
+}
+
+// Last returns the last day of the period
+func (p Period) Last() time.Time {
+	return p.To.AddDate(0, 0, -1)
+}
+
+// String returns the period in the format accepted by Parse
+func (p Period) String() string {
+	switch p.Kind {
+	case KIND_MONTH:
+		return p.From.Format("2006-01")
+	case KIND_QUARTER:
+		return fmt.Sprintf("%04d-Q%d", p.From.Year(), p.Quarter())
+	case KIND_YEAR:
+		return fmt.Sprintf("%04d", p.From.Year())
+	}
+	return p.From.Format(dateFormat) + ".." + p.Last().Format(dateFormat)
+}
+
+// Slug returns the period for the file names
+func (p Period) Slug() string {
+	return strings.ReplaceAll(p.String(), "..", "_")
+}
+
+// Dir returns the relative directory of the reports of the period, e.g. 2025/03,
+// 2025/Q1, 2025/annual or custom/2025-03-05_2025-04-10
+func (p Period) Dir() string {
+	year := fmt.Sprintf("%04d", p.From.Year())
+
+	switch p.Kind {
+	case KIND_MONTH:
+		return path.Join(year, p.From.Format("01"))
+	case KIND_QUARTER:
+		return path.Join(year, fmt.Sprintf("Q%d", p.Quarter()))
+	case KIND_YEAR:
+		return path.Join(year, "annual")
+	}
+	return path.Join(KIND_CUSTOM, p.Slug())
+}
+
+// Months returns the calendar months of the period, the first and the last ones
+// are cut to the period
+func (p Period) Months() []Period {
+	var months []Period
+	for from := time.Date(p.From.Year(), p.From.Month(), 1, 0, 0, 0, 0, p.From.Location()); from.Before(p.To); from = from.AddDate(0, 1, 0) {
+		m := Month(from.Year(), int(from.Month())).In(p.From.Location())
+		if m.From.Before(p.From) {
+			m.From = p.From
+		}
+		if m.To.After(p.To) {
+			m.To = p.To
+		}
+		months = append(months, m)
+	}
+	return months
+}
+
+// Contains reports whether t is in the period
+func (p Period) Contains(t time.Time) bool {
+	return !t.Before(p.From) && t.Before(p.To)
+}
@@ -0,0 +1,102 @@
+package price
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type point struct {
+	time  time.Time
+	price float64
+}
+
+// CSV is the price history loaded from a file with "time,price" lines.
+// The time is RFC3339 or YYYY-MM-DD (UTC), the price is positive. The first line may be a header.
+type CSV struct {
+	points []point // sorted by time
+}
+
+func NewCSV(path string) (*CSV, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	r := csv.NewReader(file)
+	r.FieldsPerRecord = 2
+	r.Comment = '#'
+	r.TrimLeadingSpace = true
+
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read prices from %s: %w", path, err)
+	}
+
+	c := &CSV{}
+	for i, rec := range records {
+		t, err := parseTime(rec[0])
+		if err != nil {
+			if i == 0 {
+				continue // header
+			}
+			return nil, fmt.Errorf("%s:%d: %w", path, i+1, err)
+		}
+
+		p, err := strconv.ParseFloat(strings.TrimSpace(rec[1]), 64)
+		if err != nil || p <= 0 {
+			return nil, fmt.Errorf("%s:%d: invalid price %q", path, i+1, rec[1])
+		}
+
+		c.points = append(c.points, point{time: t, price: p})
+	}
+
+	if len(c.points) == 0 {
+		return nil, fmt.Errorf("no prices in %s", path)
+	}
+
+	slices.SortFunc(c.points, func(a, b point) int {
+		return a.time.Compare(b.time)
+	})
+
+	return c, nil
+}
+
+func parseTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, s)
+}
+
+// PriceAt returns the last known price at the time t
+func (c *CSV) PriceAt(t time.Time) (float64, error) {
+	p, ok := lastAt(c.points, t)
+	if !ok {
+		return 0, ErrNoPrice
+	}
+	return p, nil
+}
+
+// lastAt returns the value of the last point at or before the time t
+func lastAt(points []point, t time.Time) (float64, bool) {
+	i, found := slices.BinarySearchFunc(points, t, func(p point, t time.Time) int {
+		return p.time.Compare(t)
+	})
+
+	if found {
+		return points[i].price, true
+	}
+
+	if i == 0 {
+		return 0, false
+	}
+
+	return points[i-1].price, true
+}
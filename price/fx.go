@@ -0,0 +1,131 @@
+package price
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrNoRate = errors.New("no exchange rate for the time")
+
+// Rates returns the amount of the currency that one USD buys at the time t
+type Rates interface {
+	RateAt(currency string, t time.Time) (float64, error)
+}
+
+// CSVRates is the rate history loaded from a file with "time,currency,rate" lines.
+// The time is RFC3339 or YYYY-MM-DD (UTC). The first line may be a header.
+type CSVRates struct {
+	points map[string][]point // currency -> rates sorted by time
+}
+
+func NewCSVRates(path string) (*CSVRates, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	r := csv.NewReader(file)
+	r.FieldsPerRecord = 3
+	r.Comment = '#'
+	r.TrimLeadingSpace = true
+
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read exchange rates from %s: %w", path, err)
+	}
+
+	c := &CSVRates{points: make(map[string][]point)}
+	for i, rec := range records {
+		t, err := parseTime(rec[0])
+		if err != nil {
+			if i == 0 {
+				continue // header
+			}
+			return nil, fmt.Errorf("%s:%d: %w", path, i+1, err)
+		}
+
+		rate, err := strconv.ParseFloat(strings.TrimSpace(rec[2]), 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("%s:%d: invalid rate %q", path, i+1, rec[2])
+		}
+
+		currency := strings.ToUpper(strings.TrimSpace(rec[1]))
+		c.points[currency] = append(c.points[currency], point{time: t, price: rate})
+	}
+
+	for _, points := range c.points {
+		slices.SortFunc(points, func(a, b point) int {
+			return a.time.Compare(b.time)
+		})
+	}
+
+	return c, nil
+}
+
+// RateAt returns the last known rate of the currency at the time t
+func (c *CSVRates) RateAt(currency string, t time.Time) (float64, error) {
+	if currency == "USD" {
+		return 1, nil
+	}
+
+	r, ok := lastAt(c.points[currency], t)
+	if !ok {
+		return 0, ErrNoRate
+	}
+	return r, nil
+}
+
+// CREATE TABLE public.fx_rates (
+// 	time_stamp timestamptz NOT NULL,
+// 	currency varchar(3) NOT NULL,
+// 	rate float8 NOT NULL, -- units of the currency per 1 USD
+// 	CONSTRAINT fx_rates_pkey PRIMARY KEY (currency, time_stamp)
+// );
+
+const DEFAULT_FX_TABLE = "fx_rates"
+
+// PostgresRates reads the exchange rates from the table
+type PostgresRates struct {
+	db    *sql.DB
+	query string
+}
+
+func NewPostgresRates(db *sql.DB, table string) (*PostgresRates, error) {
+	if table == "" {
+		table = DEFAULT_FX_TABLE
+	}
+
+	if !tableName.MatchString(table) {
+		return nil, fmt.Errorf("invalid exchange rate table name %q", table)
+	}
+
+	return &PostgresRates{
+		db:    db,
+		query: `SELECT rate FROM ` + table + ` WHERE currency = $1 AND time_stamp <= $2 ORDER BY time_stamp DESC LIMIT 1`,
+	}, nil
+}
+
+// RateAt returns the last known rate of the currency at the time t
+func (p *PostgresRates) RateAt(currency string, t time.Time) (float64, error) {
+	if currency == "USD" {
+		return 1, nil
+	}
+
+	var rate float64
+	err := p.db.QueryRow(p.query, currency, t).Scan(&rate)
+	if err == sql.ErrNoRows {
+		return 0, ErrNoRate
+	}
+	if err != nil {
+		return 0, err
+	}
+	return rate, nil
+}
@@ -0,0 +1,52 @@
+package price
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// CREATE TABLE public.token_prices (
+// 	time_stamp timestamptz NOT NULL,
+// 	price float8 NOT NULL,
+// 	CONSTRAINT token_prices_pkey PRIMARY KEY (time_stamp)
+// );
+
+const DEFAULT_TABLE = "token_prices"
+
+var tableName = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*(\.[a-zA-Z_][a-zA-Z0-9_]*)?$`)
+
+// Postgres reads the price history from the table
+type Postgres struct {
+	db    *sql.DB
+	query string
+}
+
+func NewPostgres(db *sql.DB, table string) (*Postgres, error) {
+	if table == "" {
+		table = DEFAULT_TABLE
+	}
+
+	if !tableName.MatchString(table) {
+		return nil, fmt.Errorf("invalid price table name %q", table)
+	}
+
+	return &Postgres{
+		db:    db,
+		query: `SELECT price FROM ` + table + ` WHERE time_stamp <= $1 ORDER BY time_stamp DESC LIMIT 1`,
+	}, nil
+}
+
+// PriceAt returns the last known price at the time t
+func (p *Postgres) PriceAt(t time.Time) (float64, error) {
+	var price float64
+	err := p.db.QueryRow(p.query, t).Scan(&price)
+	if err == sql.ErrNoRows {
+		return 0, ErrNoPrice
+	}
+	if err != nil {
+		return 0, err
+	}
+	return price, nil
+}
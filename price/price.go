@@ -0,0 +1,20 @@
+package price
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrNoPrice = errors.New("no price for the time")
+
+// Provider returns the USD price of the SAVVA token at any time
+type Provider interface {
+	PriceAt(t time.Time) (float64, error)
+}
+
+// Fixed is the same price at any time
+type Fixed float64
+
+func (f Fixed) PriceAt(t time.Time) (float64, error) {
+	return float64(f), nil
+}
@@ -0,0 +1,313 @@
+package reports
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AlexNa-Holdings/savva-reports/pdf"
+)
+
+// MonthlySchema is the JSON schema of the JSON export of any period, it keeps the
+// name of the first version. It is published by the report service, so change
+// EXPORT_SCHEMA_VERSION on incompatible changes.
+//
+//go:embed schema/monthly-v1.json
+var MonthlySchema []byte
+
+// ExplainSchema is the JSON schema of the explain sidecar
+//
+//go:embed schema/explain-v1.json
+var ExplainSchema []byte
+
+const (
+	EXPORT_SCHEMA_VERSION = 1
+	EXPORT_SCHEMA_ID      = "savva-reports/monthly-v1"
+
+	EXPLAIN_SCHEMA_VERSION = 1
+	EXPLAIN_SCHEMA_ID      = "savva-reports/explain-v1"
+)
+
+// CSV_HEADER are the columns of the CSV ledger
+var CSV_HEADER = []string{"time", "tx_hash", "contract", "type", "domain", "from", "to", "amount", "token", "price", "value", "currency"}
+
+// MonthlyExport is the JSON export of the report. The SAVVA amounts are
+// decimal strings, so they keep all 18 decimals. The fiat values are in Currency.
+type MonthlyExport struct {
+	Schema           string              `json:"$schema"`
+	SchemaVersion    int                 `json:"schema_version"`
+	Address          string              `json:"address"`
+	Period           ExportPeriod        `json:"period"`
+	Locale           string              `json:"locale"`
+	Currency         string              `json:"currency"`
+	GeneratedAt      time.Time           `json:"generated_at"`
+	PriceAtPeriodEnd float64             `json:"price_at_period_end"`
+	Summary          []ExportCategory    `json:"summary"`
+	Sponsored        []ExportSponsored   `json:"sponsored"`
+	Transactions     []ExportTransaction `json:"transactions"`
+}
+
+type ExportPeriod struct {
+	Kind     string    `json:"kind"` // month, quarter, year or custom
+	Timezone string    `json:"timezone"`
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"` // exclusive
+}
+
+type ExportCategory struct {
+	Category           string  `json:"category"`
+	Amount             string  `json:"amount"`
+	ValueAtTransaction float64 `json:"value_at_transaction"`
+	ValueAtPeriodEnd   float64 `json:"value_at_period_end"`
+}
+
+type ExportSponsored struct {
+	Author           string         `json:"author"`
+	Amount           string         `json:"amount"` // weekly payment of the user
+	TotalFromAll     string         `json:"total_from_all"`
+	ValueAtPeriodEnd float64        `json:"value_at_period_end"`
+	Domains          []ExportDomain `json:"domains"`
+}
+
+type ExportDomain struct {
+	Domain       string `json:"domain"`
+	Amount       string `json:"amount"`
+	CurrentFrame int    `json:"current_frame"`
+	TilFrame     int    `json:"til_frame"`
+}
+
+type ExportTransaction struct {
+	Time     time.Time `json:"time"`
+	TxHash   string    `json:"tx_hash"`
+	Contract string    `json:"contract"`
+	Type     string    `json:"type"`
+	Domain   string    `json:"domain,omitempty"`
+	From     string    `json:"from"`
+	To       string    `json:"to"`
+	Amount   string    `json:"amount"` // negative if sent by the user
+	Token    string    `json:"token,omitempty"`
+	SavvaCid string    `json:"savva_cid,omitempty"`
+	Price    float64   `json:"price"` // token price at the time of the transaction
+	Value    float64   `json:"value"`
+}
+
+// ExplainExport is the JSON sidecar with the records behind every figure of the summary
+type ExplainExport struct {
+	Schema        string            `json:"$schema"`
+	SchemaVersion int               `json:"schema_version"`
+	Address       string            `json:"address"`
+	Period        ExportPeriod      `json:"period"`
+	Currency      string            `json:"currency"`
+	GeneratedAt   time.Time         `json:"generated_at"`
+	Categories    []ExplainCategory `json:"categories"`
+}
+
+type ExplainCategory struct {
+	Category           string          `json:"category"`
+	Amount             string          `json:"amount"`
+	ValueAtTransaction float64         `json:"value_at_transaction"`
+	Records            []ExplainRecord `json:"records"`
+}
+
+type ExplainRecord struct {
+	Time     time.Time `json:"time"`
+	TxHash   string    `json:"tx_hash"`
+	Contract string    `json:"contract"`
+	Type     string    `json:"type"`
+	From     string    `json:"from"`
+	To       string    `json:"to"`
+	Amount   string    `json:"amount"` // signed as counted in the category
+	Value    float64   `json:"value"`
+}
+
+// formatUnits writes the amount with the decimals without rounding and grouping
+func formatUnits(amount *big.Int, decimals int) string {
+	if amount == nil {
+		return "0"
+	}
+
+	base := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	q, r := new(big.Int).QuoRem(new(big.Int).Abs(amount), base, new(big.Int))
+
+	str := q.String()
+	if r.Sign() != 0 {
+		frac := r.String()
+		frac = strings.Repeat("0", decimals-len(frac)) + frac
+		str += "." + strings.TrimRight(frac, "0")
+	}
+
+	if amount.Sign() < 0 {
+		str = "-" + str
+	}
+	return str
+}
+
+func (m *report) exportPeriod() ExportPeriod {
+	return ExportPeriod{Kind: m.period.Kind, Timezone: m.doc.Location.String(), From: m.from, To: m.to}
+}
+
+// roundFiat rounds the value to the minor units of the report currency
+func (m *report) roundFiat(v float64) float64 {
+	p := math.Pow10(m.doc.Currency.Decimals)
+	return math.Round(v*p) / p
+}
+
+// transactions returns the history in the ledger order, the amounts are signed from the user's point of view
+func (m *report) transactions() []ExportTransaction {
+	history := ledgerOrder(m.doc.History)
+
+	r := make([]ExportTransaction, 0, len(history))
+	for _, h := range history {
+		amount := signedAmount(m.doc, &h)
+
+		r = append(r, ExportTransaction{
+			Time:     h.TimeStamp.UTC(),
+			TxHash:   h.TxHash.String,
+			Contract: h.Contract.String,
+			Type:     h.Type.String,
+			Domain:   h.Domain.String,
+			From:     h.FromAddr.String,
+			To:       h.ToAddr.String,
+			Amount:   formatUnits(amount, 18),
+			Token:    h.Token.String,
+			SavvaCid: h.SavvaCid.String,
+			Price:    m.doc.PriceAt(h.TimeStamp),
+			Value:    m.roundFiat(m.doc.FiatAt(amount, h.TimeStamp)),
+		})
+	}
+
+	return r
+}
+
+// writeCSV writes the ledger of the transactions of the period
+func (m *report) writeCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write(CSV_HEADER); err != nil {
+		return err
+	}
+
+	for _, t := range m.transactions() {
+		err := cw.Write([]string{
+			t.Time.Format(time.RFC3339),
+			t.TxHash,
+			t.Contract,
+			t.Type,
+			t.Domain,
+			t.From,
+			t.To,
+			t.Amount,
+			t.Token,
+			strconv.FormatFloat(t.Price, 'f', -1, 64),
+			strconv.FormatFloat(t.Value, 'f', m.doc.Currency.Decimals, 64),
+			m.doc.Currency.Code,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeJSON writes the MonthlyExport document
+func (m *report) writeJSON(w io.Writer) error {
+	doc := m.doc
+	end_price := doc.PriceAt(valuationTime(m.to))
+
+	e := MonthlyExport{
+		Schema:           EXPORT_SCHEMA_ID,
+		SchemaVersion:    EXPORT_SCHEMA_VERSION,
+		Address:          doc.UserAddress,
+		Period:           m.exportPeriod(),
+		Locale:           doc.Locale,
+		Currency:         doc.Currency.Code,
+		GeneratedAt:      time.Now().UTC().Truncate(time.Second),
+		PriceAtPeriodEnd: end_price,
+		Summary:          []ExportCategory{},
+		Sponsored:        []ExportSponsored{},
+		Transactions:     m.transactions(),
+	}
+
+	for _, cat := range m.counters.Categories() {
+		e.Summary = append(e.Summary, ExportCategory{
+			Category:           cat.Key,
+			Amount:             formatUnits(cat.Value.Savva, 18),
+			ValueAtTransaction: m.roundFiat(cat.Value.Fiat),
+			ValueAtPeriodEnd:   m.roundFiat(pdf.Value2Float(cat.Value.Savva, 18) * end_price),
+		})
+	}
+
+	for _, s := range doc.Sponsored {
+		es := ExportSponsored{
+			Author:           s.Author,
+			Amount:           formatUnits(s.TotalAmount, 18),
+			TotalFromAll:     formatUnits(s.TotalFromAll, 18),
+			ValueAtPeriodEnd: m.roundFiat(pdf.Value2Float(s.TotalAmount, 18) * end_price),
+			Domains:          []ExportDomain{},
+		}
+
+		for _, d := range s.Domains {
+			es.Domains = append(es.Domains, ExportDomain{
+				Domain:       d.Domain,
+				Amount:       formatUnits(d.Amount, 18),
+				CurrentFrame: d.CurrentFrame,
+				TilFrame:     d.TilFrame,
+			})
+		}
+
+		e.Sponsored = append(e.Sponsored, es)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(e)
+}
+
+// writeExplain writes the ExplainExport sidecar
+func (m *report) writeExplain(w io.Writer) error {
+	e := ExplainExport{
+		Schema:        EXPLAIN_SCHEMA_ID,
+		SchemaVersion: EXPLAIN_SCHEMA_VERSION,
+		Address:       m.doc.UserAddress,
+		Period:        m.exportPeriod(),
+		Currency:      m.doc.Currency.Code,
+		GeneratedAt:   time.Now().UTC().Truncate(time.Second),
+		Categories:    []ExplainCategory{},
+	}
+
+	for _, cat := range m.counters.Categories() {
+		ec := ExplainCategory{
+			Category:           cat.Key,
+			Amount:             formatUnits(cat.Value.Savva, 18),
+			ValueAtTransaction: m.roundFiat(cat.Value.Fiat),
+			Records:            []ExplainRecord{},
+		}
+
+		for _, r := range cat.Value.Records {
+			ec.Records = append(ec.Records, ExplainRecord{
+				Time:     r.Record.TimeStamp.UTC(),
+				TxHash:   r.Record.TxHash.String,
+				Contract: r.Record.Contract.String,
+				Type:     r.Record.Type.String,
+				From:     r.Record.FromAddr.String,
+				To:       r.Record.ToAddr.String,
+				Amount:   formatUnits(r.Savva, 18),
+				Value:    m.roundFiat(r.Fiat),
+			})
+		}
+
+		e.Categories = append(e.Categories, ec)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(e)
+}
@@ -0,0 +1,50 @@
+package reports
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/AlexNa-Holdings/savva-reports/i18n"
+	"github.com/AlexNa-Holdings/savva-reports/pdf"
+	"github.com/AlexNa-Holdings/savva-reports/period"
+)
+
+// periodLabel returns the name of the period in the locale of the document, e.g. "March 2025"
+func periodLabel(doc *pdf.Doc, p period.Period) string {
+	year := p.From.Year()
+
+	switch p.Kind {
+	case period.KIND_MONTH:
+		return doc.Tf("period.month", i18n.Args{"month": doc.Lang().MonthName(int(p.From.Month())), "year": year})
+	case period.KIND_QUARTER:
+		return doc.Tf("period.quarter", i18n.Args{"quarter": p.Quarter(), "year": year})
+	case period.KIND_YEAR:
+		return doc.Tf("period.year", i18n.Args{"year": year})
+	}
+	return doc.Tf("period.custom", i18n.Args{"from": doc.FormatShortDate(p.From), "to": doc.FormatShortDate(p.Last())})
+}
+
+// coverTitle returns the two lines of the period on the cover
+func coverTitle(doc *pdf.Doc, p period.Period) (string, string) {
+	year := fmt.Sprintf("%d", p.From.Year())
+
+	switch p.Kind {
+	case period.KIND_MONTH:
+		return year, strings.ToLower(doc.Lang().MonthName(int(p.From.Month())))
+	case period.KIND_QUARTER:
+		return year, doc.Tf("cover.quarter", i18n.Args{"quarter": p.Quarter()})
+	case period.KIND_YEAR:
+		return year, doc.T("cover.year_in_review")
+	}
+	return doc.FormatShortDate(p.From), "– " + doc.FormatShortDate(p.Last())
+}
+
+// zoneName returns the name of the time zone with its offset at the time, e.g. Asia/Vladivostok (UTC+10:00)
+func zoneName(t time.Time) string {
+	name := t.Location().String()
+	if name == "UTC" {
+		return name
+	}
+	return fmt.Sprintf("%s (UTC%s)", name, t.Format("-07:00"))
+}
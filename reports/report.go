@@ -0,0 +1,296 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/AlexNa-Holdings/savva-reports/assets"
+	"github.com/AlexNa-Holdings/savva-reports/cmn"
+	"github.com/AlexNa-Holdings/savva-reports/i18n"
+	"github.com/AlexNa-Holdings/savva-reports/pdf"
+	"github.com/AlexNa-Holdings/savva-reports/period"
+	"github.com/rs/zerolog/log"
+	"github.com/signintech/gopdf"
+)
+
+const (
+	FORMAT_PDF     = "pdf"
+	FORMAT_CSV     = "csv"     // ledger of the transactions
+	FORMAT_JSON    = "json"    // see MonthlySchema
+	FORMAT_EXPLAIN = "explain" // records behind the summary figures, see ExplainSchema
+)
+
+var Formats = []string{FORMAT_PDF, FORMAT_CSV, FORMAT_JSON, FORMAT_EXPLAIN}
+
+// Extensions are the file extensions of the formats
+var Extensions = map[string]string{
+	FORMAT_PDF:     ".pdf",
+	FORMAT_CSV:     ".csv",
+	FORMAT_JSON:    ".json",
+	FORMAT_EXPLAIN: ".explain.json",
+}
+
+// Options select the content of the report
+type Options struct {
+	Locale   string
+	Currency string // ISO code, empty for the default currency of the config
+	Explain  bool   // add the records behind every summary figure to the PDF
+	Verify   bool   // verify the balances against the chain node, requires cfg.Chain
+	Strict   bool   // fail if a text has no translation to the locale
+	Timezone string // IANA name of the time zone of the period and the dates, default UTC
+}
+
+func CheckFormat(format string) error {
+	if !slices.Contains(Formats, format) {
+		return fmt.Errorf("unsupported format %q, supported: %s", format, strings.Join(Formats, ", "))
+	}
+	return nil
+}
+
+// FormatPath returns the path with the extension of the format
+func FormatPath(path, format string) string {
+	return strings.TrimSuffix(path, filepath.Ext(path)) + Extensions[format]
+}
+
+// BuildReport renders the report of the period in every format to the output path
+// with the extension of the format and returns the result of the balance
+// verification if it was requested. It is safe to call from several goroutines
+// with the same config.
+func BuildReport(cfg *cmn.Config, user_addr string, p period.Period, output_path string, formats []string, opts Options) (*Verification, error) {
+	m, err := newReport(cfg, user_addr, p, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, format := range formats {
+		path := FormatPath(output_path, format)
+
+		file, err := os.Create(path)
+		if err != nil {
+			return nil, fmt.Errorf("error creating %s: %w", path, err)
+		}
+
+		err = m.write(file, format)
+		if cerr := file.Close(); err == nil {
+			err = cerr
+		}
+
+		if err != nil {
+			log.Error().Err(err).Msgf("Error writing %s", path)
+			os.Remove(path) // not to be taken for a complete report
+			return nil, fmt.Errorf("error saving report to %s: %w", path, err)
+		}
+	}
+
+	return m.verified, nil
+}
+
+// WriteReport renders the report of the period in the format and writes it to w
+func WriteReport(w io.Writer, cfg *cmn.Config, user_addr string, p period.Period, format string, opts Options) error {
+	m, err := newReport(cfg, user_addr, p, opts)
+	if err != nil {
+		return err
+	}
+
+	return m.write(w, format)
+}
+
+// report is the data of one report shared by all the output formats
+type report struct {
+	doc      *pdf.Doc
+	period   period.Period
+	from, to time.Time // of the period
+	counters *Counters
+	balances *Balances
+	verified *Verification // nil if the verification was not requested
+	opts     Options
+	rendered bool
+}
+
+func newReport(cfg *cmn.Config, user_addr string, p period.Period, opts Options) (*report, error) {
+	if !p.From.Before(p.To) {
+		return nil, fmt.Errorf("invalid period: %s", p)
+	}
+
+	loc, err := time.LoadLocation(opts.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %q: %w", opts.Timezone, err)
+	}
+	p = p.In(loc) // the days of the period start at midnight of the user
+
+	doc, err := pdf.NewDoc(cfg, user_addr, opts.Locale, opts.Currency)
+	if err != nil {
+		log.Printf("Error initializing PDF: %v", err)
+		return nil, fmt.Errorf("failed to initialize PDF: %w", err)
+	}
+	doc.Location = loc
+
+	m := &report{
+		doc:    doc,
+		period: p,
+		from:   p.From,
+		to:     p.To,
+		opts:   opts,
+	}
+
+	doc.History, err = doc.Data.GetHistory(user_addr, &m.from, &m.to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch history: %w", err)
+	}
+
+	doc.Sponsored, err = doc.Data.GetSponsoredBy(user_addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch sponsored users: %w", err)
+	}
+
+	m.counters = calcCounters(doc, doc.History)
+
+	m.balances, err = calcBalances(doc, m.from, m.to, m.counters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate balances: %w", err)
+	}
+
+	if opts.Verify {
+		m.verified, err = verifyBalances(doc, m.from, m.to, m.balances)
+		if err != nil {
+			return nil, fmt.Errorf("failed to verify balances: %w", err)
+		}
+	}
+
+	return m, nil
+}
+
+// write writes the report in the format to w if it is complete, nothing otherwise
+func (m *report) write(w io.Writer, format string) error {
+	var buf bytes.Buffer
+	if err := m.writeFormat(&buf, format); err != nil {
+		return err
+	}
+
+	if err := m.doc.PriceError(); err != nil {
+		return fmt.Errorf("failed to value the report: %w", err)
+	}
+
+	if missing := m.doc.MissingKeys(); m.opts.Strict && len(missing) > 0 {
+		return fmt.Errorf("no %s translation for %s", m.doc.Locale, strings.Join(missing, ", "))
+	}
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func (m *report) writeFormat(w io.Writer, format string) error {
+	switch format {
+	case FORMAT_PDF:
+		if !m.rendered {
+			if err := m.render(); err != nil {
+				return err
+			}
+			m.rendered = true
+		}
+
+		if err := m.doc.Write(w); err != nil {
+			log.Printf("Error writing PDF: %v", err)
+			return fmt.Errorf("error writing PDF: %w", err)
+		}
+		return nil
+	case FORMAT_CSV:
+		return m.writeCSV(w)
+	case FORMAT_JSON:
+		return m.writeJSON(w)
+	case FORMAT_EXPLAIN:
+		return m.writeExplain(w)
+	}
+
+	return CheckFormat(format)
+}
+
+func (m *report) render() error {
+	doc := m.doc
+
+	err := coverPage(doc, m.period)
+	if err != nil {
+		log.Printf("Error creating cover page: %v", err)
+		return fmt.Errorf("error creating cover page: %w", err)
+	}
+
+	addSectionLegal(doc)
+	addSectionSponsored(doc, m.from, m.to)
+	addSectionAuthors(doc, m.from, m.to)
+	addSectionSummary(doc, m.from, m.to, m.counters)
+	addSectionBalance(doc, m.from, m.to, m.balances, m.verified)
+	if len(m.period.Months()) > 1 {
+		addSectionBreakdown(doc, m.period, m.balances)
+	}
+	if m.opts.Explain {
+		addSectionExplain(doc, m.counters)
+	}
+	addSectionLedger(doc, m.balances)
+	addTableOfContents(doc)
+
+	return nil
+}
+
+func coverPage(doc *pdf.Doc, p period.Period) error {
+	doc.AddPage()
+
+	user, err := doc.Data.GetUser(doc.UserAddress)
+	if err != nil {
+		log.Printf("Error fetching user data: %v", err)
+		return fmt.Errorf("error fetching user data: %w", err)
+	}
+
+	// Draw avatar
+	if err := doc.ImageFrom(user.AvatarImg, 60, 155, &gopdf.Rect{W: 500, H: 500}); err != nil {
+		log.Error().Err(err).Msg("Failed to draw avatar image")
+		return err
+	}
+
+	// print cmn.CoverImg on all page
+	if err := doc.ImageFrom(assets.CoverImg, 0, 0, &gopdf.Rect{W: cmn.PageWidth, H: cmn.PageHeight}); err != nil {
+		log.Error().Err(err).Msg("Failed to draw cover image")
+		return err
+	}
+
+	//	doc.SetTextColor(0xff, 0x71, 0) //SAVVA
+	doc.SetTextColor(0xff, 0xff, 0xff) //SAVVA
+	title, subtitle := coverTitle(doc, p)
+	if p.Kind == period.KIND_CUSTOM { // the dates do not fit the big font
+		doc.SetFont("DejaVuBold", "", 24)
+		doc.TextCentered(title, cmn.PageWidth-120, 62)
+		doc.TextCentered(subtitle, cmn.PageWidth-120, 94)
+	} else {
+		doc.SetFont("DejaVuBold", "", 60)
+		doc.TextCentered(title, cmn.PageWidth-120, 70)
+		doc.SetFont("DejaVuBold", "", 30)
+		doc.TextCentered(subtitle, cmn.PageWidth-120, 100)
+	}
+
+	doc.SetFont("DejaVuBold", "", 11)
+	doc.TextCentered(doc.Tf("cover.timezone", i18n.Args{"zone": zoneName(p.From)}), cmn.PageWidth-120, 122)
+
+	doc.SetFont("DejaVuBold", "", 40)
+	// doc.SetTextColor(0xc4, 0x58, 0) //Dark SAVVA
+	doc.SetTextColor(0, 0, 0) //Dark SAVVA
+	doc.TextCentered(strings.ToUpper(user.Name), 0, cmn.PageHeight-120)
+
+	doc.SetFont("DejaVuBold", "", 20)
+	// print address in form 0x1234...1234
+	doc.TextCentered(user.Address[0:6]+"..."+user.Address[len(user.Address)-4:],
+		cmn.PageWidth/2, cmn.PageHeight-95)
+
+	doc.SetFont("Arial", "", 10)
+	doc.TextCentered(doc.Tf("generated_on", i18n.Args{"time": doc.FormatTime(time.Now())}),
+		0,
+		cmn.PageHeight-70)
+
+	doc.AddBlankPage()
+
+	return nil
+}
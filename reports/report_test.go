@@ -0,0 +1,114 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/AlexNa-Holdings/savva-reports/classify"
+	"github.com/AlexNa-Holdings/savva-reports/cmn"
+	"github.com/AlexNa-Holdings/savva-reports/data"
+	"github.com/AlexNa-Holdings/savva-reports/period"
+	"github.com/AlexNa-Holdings/savva-reports/price"
+)
+
+const (
+	FIXTURES_DIR    = "../fixtures/demo"
+	FIXTURE_ADDRESS = "0xDf691828859e3Cb1e31E6D2F8A9b04F3B91A717f"
+)
+
+// fixtureConfig returns the config of the demo fixtures, see fixtures/demo/savva-reports.yaml
+func fixtureConfig(t *testing.T) *cmn.Config {
+	t.Helper()
+
+	prices, err := price.NewCSV(filepath.Join(FIXTURES_DIR, "prices.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rates, err := price.NewCSVRates(filepath.Join(FIXTURES_DIR, "fx_rates.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &cmn.Config{
+		Price:          prices,
+		FX:             rates,
+		Currency:       "USD",
+		Classification: classify.Default(),
+		IPFS:           data.FixtureIPFS(FIXTURES_DIR),
+		FixturesDir:    FIXTURES_DIR,
+	}
+}
+
+// TestBuildReportConcurrent renders the reports in all the locales and two currencies at
+// the same time with one config, run it with -race. The ledgers must not depend on the locale.
+func TestBuildReportConcurrent(t *testing.T) {
+	cfg := fixtureConfig(t)
+	dir := t.TempDir()
+
+	p, err := period.Parse("2025-Q1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	locales := []string{"en", "ru", "uk", "de", "es", "zh", "ar"}
+	formats := []string{FORMAT_PDF, FORMAT_CSV, FORMAT_JSON}
+
+	currencies := []string{"USD", "EUR"}
+	output := func(i int) string {
+		return filepath.Join(dir, fmt.Sprintf("%s-%s.pdf", locales[i], currencies[i%2]))
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(locales))
+	for i, locale := range locales {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := BuildReport(cfg, FIXTURE_ADDRESS, p, output(i), formats, Options{
+				Locale:   locale,
+				Currency: currencies[i%2],
+				Explain:  true,
+				Strict:   true,
+				Timezone: "Europe/Berlin",
+			})
+			if err != nil {
+				errs <- fmt.Errorf("%s: %w", locale, err)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+	if t.Failed() {
+		return
+	}
+
+	ledgers := make(map[string][]byte) // currency -> the first ledger
+	for i := range locales {
+		for _, format := range formats {
+			path := FormatPath(output(i), format)
+			if info, err := os.Stat(path); err != nil || info.Size() == 0 {
+				t.Errorf("%s is missing or empty", path)
+			}
+		}
+
+		ledger, err := os.ReadFile(FormatPath(output(i), FORMAT_CSV))
+		if err != nil {
+			continue
+		}
+		if want, ok := ledgers[currencies[i%2]]; !ok {
+			ledgers[currencies[i%2]] = ledger
+		} else if !bytes.Equal(ledger, want) {
+			t.Errorf("the %s ledger in %s differs from the one in %s", locales[i], currencies[i%2], locales[i-2])
+		}
+	}
+}
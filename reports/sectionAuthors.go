@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/AlexNa-Holdings/savva-reports/data"
+	"github.com/AlexNa-Holdings/savva-reports/i18n"
 	"github.com/AlexNa-Holdings/savva-reports/pdf"
 	"github.com/rs/zerolog/log"
 )
@@ -21,7 +22,7 @@ func addSectionAuthors(doc *pdf.Doc, from, to time.Time) {
 
 	if doc.Sponsored == nil {
 		var err error
-		doc.Sponsored, err = data.GetSponsoredBy(doc.UserAddress)
+		doc.Sponsored, err = doc.Data.GetSponsoredBy(doc.UserAddress)
 		if err != nil {
 			log.Error().Err(err).Msg("Failed to fetch sponsored by")
 			return
@@ -32,13 +33,13 @@ func addSectionAuthors(doc *pdf.Doc, from, to time.Time) {
 	authors := make([]AuthorToShow, 0)
 	for _, s := range doc.Sponsored {
 
-		user, err := data.GetUser(s.Author)
+		user, err := doc.Data.GetUser(s.Author)
 		if err != nil {
 			log.Error().Err(err).Msg("Failed to fetch user data")
 			continue
 		}
 
-		posts, err := data.GetPostsByAuthor(s.Author, doc.UserAddress, from, to)
+		posts, err := doc.Data.GetPostsByAuthor(s.Author, doc.UserAddress, from, to)
 		if err != nil {
 			log.Error().Err(err).Msg("Failed to fetch posts by author")
 			continue
@@ -69,7 +70,7 @@ func addSectionAuthors(doc *pdf.Doc, from, to time.Time) {
 
 	doc.NewSection(doc.T("authors.title"))
 
-	doc.MarkDownToPdf(doc.T("authors.introduction"))
+	doc.MarkDownToPdf(doc.Tf("authors.introduction", i18n.Args{"count": len(authors)}))
 	doc.NewLine()
 
 	for _, author := range authors {
@@ -83,16 +84,17 @@ func addSectionAuthors(doc *pdf.Doc, from, to time.Time) {
 
 			doc.NewSubSubSection(post.GetTitle(doc.Locale))
 			// doc.ImageFrom(post.ThumbnailImg, doc.GetX(), doc.GetY(), &gopdf.Rect{W: 160, H: 100})
-			doc.DrawImageCover(post.ThumbnailImg, doc.GetX(), doc.GetY(), 160, 100)
+			// the thumbnail is at the start of the line, the info after it
+			x := doc.GetX()
+			doc.DrawImageCover(post.ThumbnailImg, doc.MirrorX(x, 160), doc.GetY(), 160, 100)
 
 			info := ""
 
-			info += "*" + doc.T("posted") + "*: " + post.EffectiveTime.Format(time.RFC822) + "\n"
+			info += "*" + doc.T("posted") + "*: " + doc.FormatTime(post.EffectiveTime) + "\n"
 			info += "*" + doc.T("domain") + "*: " + post.Domain + "\n"
 
-			doc.MarkDownToPdfEx(info, doc.GetX()+170, doc.GetY(),
-				doc.PageWidth-doc.Margins.Right-doc.GetX()+170,
-				100, false)
+			w := doc.PageWidth - doc.Margins.Right - x - 170
+			doc.MarkDownToPdfEx(info, doc.MirrorX(x+170, w), doc.GetY(), w, 100, false)
 
 			content, err := post.GetContent(doc.Locale)
 			if err != nil {
@@ -106,7 +108,7 @@ func addSectionAuthors(doc *pdf.Doc, from, to time.Time) {
 			doc.GetImage = func(url string) (image.Image, error) {
 				return post.GetImage(url)
 			}
-			doc.MarkDownToPdf(content)
+			doc.MarkDownToPdfWithStyle(content, &doc.PostStyle)
 			doc.GetImage = nil
 		}
 	}
@@ -0,0 +1,211 @@
+package reports
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/AlexNa-Holdings/savva-reports/classify"
+	"github.com/AlexNa-Holdings/savva-reports/i18n"
+	"github.com/AlexNa-Holdings/savva-reports/pdf"
+)
+
+// Balance is the reconciliation of one balance over the period
+type Balance struct {
+	Opening  *big.Int // before the start of the period
+	Inflows  *big.Int
+	Outflows *big.Int // positive
+	Closing  *big.Int // at the end of the period
+}
+
+func newBalance() Balance {
+	return Balance{Opening: new(big.Int), Inflows: new(big.Int), Outflows: new(big.Int), Closing: new(big.Int)}
+}
+
+// Expected returns opening + inflows - outflows
+func (b *Balance) Expected() *big.Int {
+	e := new(big.Int).Add(b.Opening, b.Inflows)
+	return e.Sub(e, b.Outflows)
+}
+
+// addFlow counts the amount multiplied by the effect of its category
+func (b *Balance) addFlow(amount *big.Int, effect int) {
+	switch v := new(big.Int).Mul(amount, big.NewInt(int64(effect))); v.Sign() {
+	case 1:
+		b.Inflows.Add(b.Inflows, v)
+	case -1:
+		b.Outflows.Sub(b.Outflows, v)
+	}
+}
+
+// Balances are the SAVVA balances of the user computed from the history
+type Balances struct {
+	Wallet Balance
+	Staked Balance
+
+	CurrentStaked  *big.Int // from the whole history
+	ContractStaked *big.Int // as loaded with the user
+	Unclassified   int      // records of the period that change no balance
+}
+
+// StakedMismatch is true if the history does not explain the current stake
+func (b *Balances) StakedMismatch() bool {
+	return b.CurrentStaked.Cmp(b.ContractStaked) != 0
+}
+
+// Mismatch is true if the current stake or the closing balances verified against the
+// chain differ from the history. The closing balances add up with the flows by
+// construction, they come from the same history.
+func (b *Balances) Mismatch(v *Verification) bool {
+	if b.StakedMismatch() {
+		return true
+	}
+	if v == nil {
+		return false
+	}
+	for _, c := range v.Discrepancies() {
+		if c.Boundary == BOUNDARY_CLOSING {
+			return true
+		}
+	}
+	return false
+}
+
+// calcBalances computes the balances at the start and at the end of the period from
+// the whole history and the flows of the period from the summary counters
+func calcBalances(doc *pdf.Doc, from, to time.Time, c *Counters) (*Balances, error) {
+	history, err := doc.Data.GetHistory(doc.UserAddress, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the whole history: %w", err)
+	}
+
+	user, err := doc.Data.GetUser(doc.UserAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	b := &Balances{
+		Wallet:         newBalance(),
+		Staked:         newBalance(),
+		CurrentStaked:  new(big.Int),
+		ContractStaked: new(big.Int).Set(&user.Staked),
+	}
+
+	rules := classification(doc)
+	for i := range history {
+		h := &history[i]
+		category, amount := classifyRecord(doc, h)
+		wallet, staked := rules.Effect(category)
+
+		w := new(big.Int).Mul(amount, big.NewInt(int64(wallet)))
+		s := new(big.Int).Mul(amount, big.NewInt(int64(staked)))
+
+		if h.TimeStamp.Before(from) {
+			b.Wallet.Opening.Add(b.Wallet.Opening, w)
+			b.Staked.Opening.Add(b.Staked.Opening, s)
+		}
+
+		// the period excludes its end as the history of the period does
+		if h.TimeStamp.Before(to) {
+			b.Wallet.Closing.Add(b.Wallet.Closing, w)
+			b.Staked.Closing.Add(b.Staked.Closing, s)
+		}
+
+		b.CurrentStaked.Add(b.CurrentStaked, s)
+	}
+
+	b.Unclassified = addFlows(rules, c, &b.Wallet, &b.Staked)
+
+	return b, nil
+}
+
+// addFlows adds the inflows and the outflows of the counters to the balances and
+// returns the number of the records that change none of them
+func addFlows(rules *classify.Rules, c *Counters, wallet_b, staked_b *Balance) int {
+	unclassified := 0
+	for _, cat := range c.Categories() {
+		wallet, staked := rules.Effect(cat.Key)
+		if wallet == 0 && staked == 0 {
+			unclassified += len(cat.Value.Records)
+			continue
+		}
+
+		for _, r := range cat.Value.Records {
+			wallet_b.addFlow(r.Savva, wallet)
+			staked_b.addFlow(r.Savva, staked)
+		}
+	}
+	return unclassified
+}
+
+func addSectionBalance(doc *pdf.Doc, from, to time.Time, b *Balances, v *Verification) {
+	doc.NewSection(doc.T("balance.title"))
+	doc.MarkDownToPdf(doc.T("balance.introduction"))
+	doc.NewLine()
+
+	if b.Mismatch(v) {
+		doc.WarningBox(doc.T("balance.mismatch"))
+	}
+
+	t := doc.NewTable()
+	t.SetHeader(doc.T("description"), doc.T("balance.wallet"), doc.T("balance.staked"))
+	t.ColWidths = []float64{0, 120, 120}
+
+	t.ColStyle[1].Align = 'R'
+	t.ColStyle[2].Align = 'R'
+
+	row := func(title string, wallet, staked *big.Int) {
+		t.AddRow(title, doc.FormatValue(wallet, 18), doc.FormatValue(staked, 18))
+	}
+
+	row(doc.Tf("balance.opening", i18n.Args{"date": doc.FormatTime(from)}), b.Wallet.Opening, b.Staked.Opening)
+	row(doc.T("balance.inflows"), b.Wallet.Inflows, b.Staked.Inflows)
+	row(doc.T("balance.outflows"), new(big.Int).Neg(b.Wallet.Outflows), new(big.Int).Neg(b.Staked.Outflows))
+	row(doc.Tf("balance.closing", i18n.Args{"date": doc.FormatTime(to)}), b.Wallet.Closing, b.Staked.Closing)
+
+	// only the chain is independent of the history, the difference is the last row
+	if wallet, staked := v.closing(BALANCE_WALLET), v.closing(BALANCE_STAKED); wallet != nil && staked != nil {
+		row(doc.T("balance.chain_closing"), wallet.Chain, staked.Chain)
+		row(doc.T("balance.difference"), wallet.Difference(), staked.Difference())
+
+		differs := []bool{false, wallet.Difference().Sign() != 0, staked.Difference().Sign() != 0}
+		t.OnBeforeDrawCell = func(t *pdf.Table, r, col int, x, y, w, h float64, text string, style *pdf.Style) {
+			if r == t.H-1 && differs[col] {
+				style.FontName = "DejaVuBold"
+				style.FontColor = &pdf.WARNING_COLOR
+			}
+		}
+	}
+
+	doc.WriteTable(t)
+	doc.NewLine()
+
+	if v == nil {
+		doc.MarkDownToPdf(doc.T("balance.unverified"))
+		doc.NewLine()
+	}
+
+	doc.MarkDownToPdf(doc.Tf("balance.current_staked", i18n.Args{
+		"contract": doc.FormatValue(b.ContractStaked, 18),
+		"history":  doc.FormatValue(b.CurrentStaked, 18),
+	}))
+	if b.StakedMismatch() {
+		doc.NewLine()
+		doc.WarningBox(doc.Tf("balance.staked_mismatch", i18n.Args{
+			"difference": doc.FormatValue(new(big.Int).Sub(b.ContractStaked, b.CurrentStaked), 18),
+		}))
+	}
+
+	if b.Unclassified > 0 {
+		doc.NewLine()
+		doc.MarkDownToPdf(doc.Tf("balance.unclassified", i18n.Args{
+			"count":    b.Unclassified,
+			"category": doc.T("summary." + classify.OTHER),
+		}))
+	}
+
+	if v != nil {
+		doc.NewLine()
+		addVerification(doc, v)
+	}
+}
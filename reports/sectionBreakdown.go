@@ -0,0 +1,126 @@
+package reports
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/AlexNa-Holdings/savva-reports/data"
+	"github.com/AlexNa-Holdings/savva-reports/pdf"
+	"github.com/AlexNa-Holdings/savva-reports/period"
+)
+
+// monthFlows are the totals of one month of the period
+type monthFlows struct {
+	Month    period.Period
+	Counters *Counters
+	Wallet   Balance
+	Staked   Balance
+}
+
+// calcMonths splits the history of the period by months. The opening balance of
+// every month is the closing one of the previous month.
+func calcMonths(doc *pdf.Doc, p period.Period, b *Balances) []monthFlows {
+	months := p.Months()
+	records := make([][]data.HistoryRecord, len(months))
+	for _, h := range doc.History {
+		if i := monthIndex(months, h.TimeStamp); i >= 0 {
+			records[i] = append(records[i], h)
+		}
+	}
+
+	rules := classification(doc)
+	wallet, staked := b.Wallet.Opening, b.Staked.Opening
+
+	r := make([]monthFlows, len(months))
+	for i, m := range months {
+		r[i] = monthFlows{Month: m, Counters: calcCounters(doc, records[i]), Wallet: newBalance(), Staked: newBalance()}
+		addFlows(rules, r[i].Counters, &r[i].Wallet, &r[i].Staked)
+
+		r[i].Wallet.Opening.Set(wallet)
+		r[i].Staked.Opening.Set(staked)
+		r[i].Wallet.Closing = r[i].Wallet.Expected()
+		r[i].Staked.Closing = r[i].Staked.Expected()
+		wallet, staked = r[i].Wallet.Closing, r[i].Staked.Closing
+	}
+
+	return r
+}
+
+// monthIndex returns the month of the time or -1
+func monthIndex(months []period.Period, t time.Time) int {
+	for i, m := range months {
+		if m.Contains(t) {
+			return i
+		}
+	}
+	return -1
+}
+
+// addSectionBreakdown shows the balances and the summary of every month of the period
+func addSectionBreakdown(doc *pdf.Doc, p period.Period, b *Balances) {
+	months := calcMonths(doc, p, b)
+
+	doc.NewSection(doc.T("breakdown.title"))
+	doc.MarkDownToPdf(doc.T("breakdown.introduction"))
+	doc.NewLine()
+
+	header_style := doc.TableHeaderStyle
+	doc.TableHeaderStyle.FontSize = 10
+	defer func() { doc.TableHeaderStyle = header_style }()
+
+	doc.NewSubSection(doc.T("breakdown.balances"))
+
+	t := doc.NewTable()
+	t.CellStyle.FontSize = 10
+	t.SetHeader(doc.T("breakdown.month"), doc.T("balance.opening_short"), doc.T("balance.inflows"),
+		doc.T("balance.outflows"), doc.T("balance.closing_short"), doc.T("balance.staked"))
+	t.ColWidths = []float64{0, 80, 80, 80, 80, 80}
+
+	for i := 1; i < 6; i++ {
+		t.ColStyle[i].Align = 'R'
+	}
+
+	for _, m := range months {
+		t.AddRow(
+			periodLabel(doc, m.Month),
+			doc.FormatValue(m.Wallet.Opening, 18),
+			doc.FormatValue(m.Wallet.Inflows, 18),
+			doc.FormatValue(new(big.Int).Neg(m.Wallet.Outflows), 18),
+			doc.FormatValue(m.Wallet.Closing, 18),
+			doc.FormatValue(m.Staked.Closing, 18),
+		)
+	}
+
+	doc.WriteTable(t)
+	doc.NewLine()
+
+	doc.NewSubSection(doc.T("breakdown.categories"))
+
+	t = doc.NewTable()
+	t.CellStyle.FontSize = 10
+	t.SetHeader(doc.T("description"), "SAVVA", doc.T("summary.value_at_transaction"))
+	t.ColWidths = []float64{0, 95, 95}
+
+	t.ColStyle[1].Align = 'R'
+	t.ColStyle[2].Align = 'R'
+
+	for _, m := range months {
+		t.AddGroupRow(periodLabel(doc, m.Month))
+
+		empty := true
+		for _, cat := range m.Counters.Categories() {
+			if len(cat.Value.Records) == 0 {
+				continue
+			}
+
+			empty = false
+			t.AddRow(doc.T(cat.I18n), doc.FormatValue(cat.Value.Savva, 18), doc.FormatFiat(cat.Value.Fiat))
+		}
+
+		if empty {
+			t.AddRow(doc.T("breakdown.no_transactions"), "", "")
+		}
+	}
+
+	doc.WriteTable(t)
+}
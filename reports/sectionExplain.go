@@ -0,0 +1,54 @@
+package reports
+
+import (
+	"github.com/AlexNa-Holdings/savva-reports/i18n"
+	"github.com/AlexNa-Holdings/savva-reports/pdf"
+)
+
+// addSectionExplain lists the records behind every figure of the summary
+func addSectionExplain(doc *pdf.Doc, c *Counters) {
+	doc.NewSection(doc.T("explain.title"))
+	doc.MarkDownToPdf(doc.T("explain.introduction"))
+
+	header_style := doc.TableHeaderStyle
+	doc.TableHeaderStyle.FontSize = 10
+	defer func() { doc.TableHeaderStyle = header_style }()
+
+	for _, cat := range c.Categories() {
+		if len(cat.Value.Records) == 0 {
+			continue
+		}
+
+		doc.NewSubSection(doc.T(cat.I18n))
+
+		doc.MarkDownToPdf(doc.Tf("explain.category_total", i18n.Args{
+			"count": len(cat.Value.Records),
+			"total": doc.FormatValue(cat.Value.Savva, 18),
+			"fiat":  doc.FormatFiat(cat.Value.Fiat),
+		}))
+		doc.NewLine()
+
+		t := doc.NewTable()
+		t.CellStyle.FontSize = LEDGER_FONT_SIZE
+		t.SetHeader(doc.T("ledger.time"), doc.T("explain.tx_hash"), "SAVVA", doc.T("summary.value_at_transaction"))
+		t.ColWidths = []float64{70, 0, 75, 75}
+
+		t.ColStyle[1].FontName = "Mono"
+		t.ColStyle[1].FontSize = 6.5
+		t.ColStyle[1].Padding.Left = 3
+		t.ColStyle[1].Padding.Top += 1.5 // align with the bigger font of the other columns
+		t.ColStyle[2].Align = 'R'
+		t.ColStyle[3].Align = 'R'
+
+		for _, r := range cat.Value.Records {
+			t.AddRow(
+				doc.FormatShortDate(r.Record.TimeStamp)+" "+doc.FormatTimeOfDay(r.Record.TimeStamp),
+				r.Record.TxHash.String,
+				doc.FormatValue(r.Savva, 18),
+				doc.FormatFiat(r.Fiat),
+			)
+		}
+
+		doc.WriteTable(t)
+	}
+}
@@ -0,0 +1,138 @@
+package reports
+
+import (
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/AlexNa-Holdings/savva-reports/data"
+	"github.com/AlexNa-Holdings/savva-reports/i18n"
+	"github.com/AlexNa-Holdings/savva-reports/pdf"
+	"github.com/rs/zerolog/log"
+)
+
+const LEDGER_FONT_SIZE = 9.
+
+// signedAmount returns the amount of the record, negative if it was sent by the user
+func signedAmount(doc *pdf.Doc, h *data.HistoryRecord) *big.Int {
+	amount := new(big.Int)
+	if h.Amount != nil {
+		amount.Set(h.Amount)
+	}
+
+	if strings.EqualFold(h.FromAddr.String, doc.UserAddress) {
+		amount.Neg(amount)
+	}
+	return amount
+}
+
+// counterparty returns the other side of the record
+func counterparty(doc *pdf.Doc, h *data.HistoryRecord) string {
+	if strings.EqualFold(h.FromAddr.String, doc.UserAddress) {
+		return h.ToAddr.String
+	}
+	return h.FromAddr.String
+}
+
+func shortHash(hash string) string {
+	if len(hash) < 12 {
+		return hash
+	}
+	return hash[0:6] + "..." + hash[len(hash)-4:]
+}
+
+// ledgerOrder returns the history sorted from the oldest record
+func ledgerOrder(history []data.HistoryRecord) []data.HistoryRecord {
+	r := slices.Clone(history)
+	slices.SortStableFunc(r, func(a, b data.HistoryRecord) int {
+		return a.TimeStamp.Compare(b.TimeStamp)
+	})
+	return r
+}
+
+// addSectionLedger lists all the transactions of the period grouped by day with the
+// wallet balance after each of them, from the opening to the closing balance of b
+func addSectionLedger(doc *pdf.Doc, b *Balances) {
+	doc.NewSection(doc.T("ledger.title"))
+
+	if len(doc.History) == 0 {
+		doc.MarkDownToPdf(doc.T("ledger.no_transactions"))
+		return
+	}
+
+	doc.MarkDownToPdf(doc.Tf("ledger.introduction", i18n.Args{"count": len(doc.History)}))
+	doc.NewLine()
+
+	// the ledger needs more columns than the other tables
+	header_style := doc.TableHeaderStyle
+	doc.TableHeaderStyle.FontSize = 10
+	defer func() { doc.TableHeaderStyle = header_style }()
+
+	t := doc.NewTable()
+	t.CellStyle.FontSize = LEDGER_FONT_SIZE
+	t.GroupStyle.FontSize = LEDGER_FONT_SIZE + 1
+	t.SetHeader(doc.T("ledger.time"), doc.T("ledger.transaction"), doc.T("ledger.counterparty"),
+		"SAVVA", doc.T("ledger.balance"), doc.T("ledger.tx"))
+	t.ColWidths = []float64{40, 0, 95, 75, 80, 80}
+
+	t.ColStyle[3].Align = 'R'
+	t.ColStyle[4].Align = 'R'
+	t.ColStyle[5].FontName = "Mono"
+
+	names := make(map[string]string) // address -> name
+	name := func(addr string) string {
+		if addr == "" || addr == data.ZERO_ADDRESS {
+			return "-"
+		}
+
+		if n, ok := names[addr]; ok {
+			return n
+		}
+
+		n := addr
+		user, err := doc.Data.GetUser(addr)
+		if err != nil {
+			log.Error().Err(err).Msgf("Failed to fetch user %s", addr)
+		} else {
+			n = user.BestName()
+		}
+
+		names[addr] = n
+		return n
+	}
+
+	rules := classification(doc)
+	balance := new(big.Int).Set(b.Wallet.Opening)
+	day := ""
+
+	for _, h := range ledgerOrder(doc.History) {
+		if d := doc.FormatDate(h.TimeStamp); d != day {
+			day = d
+			t.AddGroupRow(d)
+		}
+
+		// the wallet changes as in calcBalances, the stake moves and the unclassified records
+		// do not change it
+		category, classified := classifyRecord(doc, &h)
+		wallet, _ := rules.Effect(category)
+		balance.Add(balance, classified.Mul(classified, big.NewInt(int64(wallet))))
+
+		amount := signedAmount(doc, &h)
+
+		tx := h.Contract.String + " / " + h.Type.String
+		if h.Domain.String != "" {
+			tx += " (" + h.Domain.String + ")"
+		}
+
+		t.AddRow(
+			doc.FormatTimeOfDay(h.TimeStamp),
+			tx,
+			name(counterparty(doc, &h)),
+			doc.FormatValue(amount, 18),
+			doc.FormatValue(balance, 18),
+			shortHash(h.TxHash.String),
+		)
+	}
+
+	doc.WriteTable(t)
+}
@@ -1,13 +1,11 @@
 package reports
 
 import (
-	"fmt"
 	"math/big"
 	"strings"
 	"time"
 
-	"github.com/AlexNa-Holdings/savva-reports/cmn"
-	"github.com/AlexNa-Holdings/savva-reports/data"
+	"github.com/AlexNa-Holdings/savva-reports/i18n"
 	"github.com/AlexNa-Holdings/savva-reports/pdf"
 	"github.com/rs/zerolog/log"
 	"github.com/signintech/gopdf"
@@ -19,7 +17,7 @@ func addSectionSponsored(doc *pdf.Doc, from, to time.Time) {
 
 	if doc.History == nil {
 		var err error
-		doc.History, err = data.GetHistory(doc.UserAddress, &from, &to)
+		doc.History, err = doc.Data.GetHistory(doc.UserAddress, &from, &to)
 		if err != nil {
 			log.Error().Err(err).Msg("Failed to fetch history")
 			return
@@ -28,7 +26,7 @@ func addSectionSponsored(doc *pdf.Doc, from, to time.Time) {
 
 	if doc.Sponsored == nil {
 		var err error
-		doc.Sponsored, err = data.GetSponsoredBy(doc.UserAddress)
+		doc.Sponsored, err = doc.Data.GetSponsoredBy(doc.UserAddress)
 		if err != nil {
 			log.Error().Err(err).Msg("Failed to fetch sponsored by")
 			return
@@ -45,14 +43,20 @@ func addSectionSponsored(doc *pdf.Doc, from, to time.Time) {
 		total.Add(total, s.TotalAmount)
 	}
 
+	// the weekly amounts are valued at the end of the period
+	price := doc.PriceAt(valuationTime(to))
+
 	// Add a new section for the summary
 	doc.NewSection(doc.T("sponsored.title"))
 
-	doc.MarkDownToPdf(fmt.Sprintf(doc.T("sponsored.introduction"), doc.FormatValue(total, 18)) + " SAVVA (" + doc.FormatFiat(pdf.Value2Float(total, 18)*cmn.C.SavvaTokenPrice) + ")")
+	doc.MarkDownToPdf(doc.Tf("sponsored.introduction", i18n.Args{
+		"total": doc.FormatValue(total, 18),
+		"fiat":  doc.FormatFiat(pdf.Value2Float(total, 18) * price),
+	}))
 	doc.NewLine()
 
-	t := pdf.NewTable()
-	t.SetHeader(doc.T("account"), "SAVVA", cmn.C.CurrencySymbol)
+	t := doc.NewTable()
+	t.SetHeader(doc.T("account"), "SAVVA", doc.Currency.Code)
 	t.ColWidths = []float64{0, 100, 100}
 
 	t.ColStyle[0].MinHeight = AVATAR_SIZE
@@ -64,7 +68,7 @@ func addSectionSponsored(doc *pdf.Doc, from, to time.Time) {
 	t.OnBeforeDrawCell = func(t *pdf.Table, row, col int, x, y float64, w float64, h float64, text string, style *pdf.Style) {
 		switch col {
 		case 0:
-			user, err := data.GetUser(doc.Sponsored[row].Author)
+			user, err := doc.Data.GetUser(doc.Sponsored[row].Author)
 			if err == nil {
 				doc.ImageFrom(user.AvatarImg, x+5, y+style.Padding.Top, &gopdf.Rect{W: AVATAR_SIZE, H: AVATAR_SIZE})
 			} else {
@@ -75,7 +79,7 @@ func addSectionSponsored(doc *pdf.Doc, from, to time.Time) {
 
 	for _, s := range doc.Sponsored {
 		info := ""
-		user, err := data.GetUser(s.Author)
+		user, err := doc.Data.GetUser(s.Author)
 		if err == nil {
 
 			info += "!MD"
@@ -90,17 +94,17 @@ func addSectionSponsored(doc *pdf.Doc, from, to time.Time) {
 
 			info += user.Address[0:6] + "..." + user.Address[len(user.Address)-4:] + "\n"
 
-			fiat_total_from_all := pdf.Value2Float(s.TotalFromAll, 18) * cmn.C.SavvaTokenPrice
+			fiat_total_from_all := pdf.Value2Float(s.TotalFromAll, 18) * price
 			info += doc.T("total") + ": " + doc.FormatValue(s.TotalFromAll, 18) + " " + doc.FormatFiat(fiat_total_from_all) + "\n"
 
 			if s.TotalFromAll != nil && s.TotalFromAll.Cmp(big.NewInt(0)) != 0 { // just to be sure
 				myshare10 := new(big.Int).Div(new(big.Int).Mul(s.TotalAmount, big.NewInt(10000)), s.TotalFromAll)
 				myshare := float64(myshare10.Int64()) / 100.0
-				info += fmt.Sprintf(doc.T("my_share")+": %.2f%%\n", myshare)
+				info += doc.T("my_share") + ": " + doc.FormatPercent(myshare, 2) + "\n"
 			}
 		}
 
-		fiat := pdf.Value2Float(s.TotalAmount, 18) * cmn.C.SavvaTokenPrice
+		fiat := pdf.Value2Float(s.TotalAmount, 18) * price
 		t.AddRow(info, doc.FormatValue(s.TotalAmount, 18), doc.FormatFiat(fiat))
 
 	}
@@ -1,265 +1,139 @@
 package reports
 
 import (
-	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
-	"github.com/AlexNa-Holdings/savva-reports/cmn"
+	"github.com/AlexNa-Holdings/savva-reports/classify"
 	"github.com/AlexNa-Holdings/savva-reports/data"
+	"github.com/AlexNa-Holdings/savva-reports/i18n"
 	"github.com/AlexNa-Holdings/savva-reports/pdf"
-	"github.com/rs/zerolog/log"
 )
 
-func addSectionSummary(doc *pdf.Doc, from, to time.Time) {
+func addSectionSummary(doc *pdf.Doc, from, to time.Time, c *Counters) {
 	// Add a new section for the summary
 	doc.NewSection(doc.T("summary.title"))
 
-	if doc.History == nil {
-		var err error
-		doc.History, err = data.GetHistory(doc.UserAddress, &from, &to)
-		if err != nil {
-			log.Error().Err(err).Msg("Failed to fetch history")
-			return
-		}
-	}
-
-	f := doc.T("summary.introduction")
+	// the flows are valued at the time of every transaction and at the end of the period
+	end_price := doc.PriceAt(valuationTime(to))
 
-	doc.MarkDownToPdf(fmt.Sprintf(f, from.UTC().Format(time.RFC822), to.UTC().Format(time.RFC822)))
+	doc.MarkDownToPdf(doc.Tf("summary.introduction", i18n.Args{"from": doc.FormatTime(from), "to": doc.FormatTime(to)}))
+	doc.MarkDownToPdf(doc.Tf("summary.price_at_period_end", i18n.Args{"price": doc.FormatPrice(end_price)}))
 	doc.NewLine()
 
-	t := pdf.NewTable()
-	t.SetHeader(doc.T("description"), "SAVVA", cmn.C.CurrencySymbol)
-	t.ColWidths = []float64{0, 100, 100}
+	t := doc.NewTable()
+	t.SetHeader(doc.T("description"), "SAVVA", doc.T("summary.value_at_transaction"), doc.T("summary.value_at_period_end"))
+	t.ColWidths = []float64{0, 95, 95, 95}
 
 	t.ColStyle[1].Align = 'R'
 	t.ColStyle[2].Align = 'R'
+	t.ColStyle[3].Align = 'R'
 
-	var fiat float64
-
-	c := calcCounters(doc)
+	for _, cat := range c.Categories() {
+		if cat.Key == classify.OTHER && len(cat.Value.Records) == 0 {
+			continue // shown only if something is not classified
+		}
 
-	fiat = pdf.Value2Float(c.savva_in, 18) * cmn.C.SavvaTokenPrice
-	t.AddRow(doc.T("summary.savva_in"), doc.FormatValue(c.savva_in, 18), doc.FormatFiat(fiat))
-	fiat = pdf.Value2Float(c.savva_out, 18) * cmn.C.SavvaTokenPrice
-	t.AddRow(doc.T("summary.savva_out"), doc.FormatValue(c.savva_out, 18), doc.FormatFiat(fiat))
-	fiat = pdf.Value2Float(c.donations_contribute, 18) * cmn.C.SavvaTokenPrice
-	t.AddRow(doc.T("summary.donations_contribute"), doc.FormatValue(c.donations_contribute, 18), doc.FormatFiat(fiat))
-	fiat = pdf.Value2Float(c.donations_received, 18) * cmn.C.SavvaTokenPrice
-	t.AddRow(doc.T("summary.donations_received"), doc.FormatValue(c.donations_received, 18), doc.FormatFiat(fiat))
-	fiat = pdf.Value2Float(c.fund_contributed, 18) * cmn.C.SavvaTokenPrice
-	t.AddRow(doc.T("summary.fund_contributed"), doc.FormatValue(c.fund_contributed, 18), doc.FormatFiat(fiat))
-	fiat = pdf.Value2Float(c.fund_prizes_won, 18) * cmn.C.SavvaTokenPrice
-	t.AddRow(doc.T("summary.fund_prizes_won"), doc.FormatValue(c.fund_prizes_won, 18), doc.FormatFiat(fiat))
-	fiat = pdf.Value2Float(c.staking_in, 18) * cmn.C.SavvaTokenPrice
-	t.AddRow(doc.T("summary.staking_in"), doc.FormatValue(c.staking_in, 18), doc.FormatFiat(fiat))
-	fiat = pdf.Value2Float(c.staking_out, 18) * cmn.C.SavvaTokenPrice
-	t.AddRow(doc.T("summary.staking_out"), doc.FormatValue(c.staking_out, 18), doc.FormatFiat(fiat))
-	fiat = pdf.Value2Float(c.staking_staked, 18) * cmn.C.SavvaTokenPrice
-	t.AddRow(doc.T("summary.staking_staked"), doc.FormatValue(c.staking_staked, 18), doc.FormatFiat(fiat))
-	fiat = pdf.Value2Float(c.club_buy, 18) * cmn.C.SavvaTokenPrice
-	t.AddRow(doc.T("summary.club_buy"), doc.FormatValue(c.club_buy, 18), doc.FormatFiat(fiat))
-	fiat = pdf.Value2Float(c.club_claimed, 18) * cmn.C.SavvaTokenPrice
-	t.AddRow(doc.T("summary.club_claimed"), doc.FormatValue(c.club_claimed, 18), doc.FormatFiat(fiat))
-	fiat = pdf.Value2Float(c.fundrase_contributed, 18) * cmn.C.SavvaTokenPrice
-	t.AddRow(doc.T("summary.fundrase_contributed"), doc.FormatValue(c.fundrase_contributed, 18), doc.FormatFiat(fiat))
-	fiat = pdf.Value2Float(c.fundrase_received, 18) * cmn.C.SavvaTokenPrice
-	t.AddRow(doc.T("summary.fundrase_received"), doc.FormatValue(c.fundrase_received, 18), doc.FormatFiat(fiat))
-	fiat = pdf.Value2Float(c.paid_for_promotion, 18) * cmn.C.SavvaTokenPrice
-	t.AddRow(doc.T("summary.paid_for_promotion"), doc.FormatValue(c.paid_for_promotion, 18), doc.FormatFiat(fiat))
-	fiat = pdf.Value2Float(c.nft_share_received, 18) * cmn.C.SavvaTokenPrice
-	t.AddRow(doc.T("summary.nft_share_received"), doc.FormatValue(c.nft_share_received, 18), doc.FormatFiat(fiat))
-	fiat = pdf.Value2Float(c.nft_sold_received, 18) * cmn.C.SavvaTokenPrice
-	t.AddRow(doc.T("summary.nft_sold_received"), doc.FormatValue(c.nft_sold_received, 18), doc.FormatFiat(fiat))
-	fiat = pdf.Value2Float(c.nft_auctions_bids, 18) * cmn.C.SavvaTokenPrice
-	t.AddRow(doc.T("summary.nft_auctions_bids"), doc.FormatValue(c.nft_auctions_bids, 18), doc.FormatFiat(fiat))
-	fiat = pdf.Value2Float(c.nft_auctions_received, 18) * cmn.C.SavvaTokenPrice
-	t.AddRow(doc.T("summary.nft_auctions_received"), doc.FormatValue(c.nft_auctions_received, 18), doc.FormatFiat(fiat))
+		t.AddRow(doc.T(cat.I18n), doc.FormatValue(cat.Value.Savva, 18), doc.FormatFiat(cat.Value.Fiat), doc.FormatFiat(pdf.Value2Float(cat.Value.Savva, 18)*end_price))
+	}
 
 	doc.WriteTable(t)
 
 }
 
-type Counters struct {
-	savva_in              *big.Int
-	savva_out             *big.Int
-	donations_contribute  *big.Int
-	donations_received    *big.Int
-	fund_contributed      *big.Int
-	fund_prizes_won       *big.Int
-	staking_in            *big.Int
-	staking_out           *big.Int
-	staking_staked        *big.Int
-	club_buy              *big.Int
-	club_claimed          *big.Int
-	fundrase_contributed  *big.Int
-	fundrase_received     *big.Int
-	paid_for_promotion    *big.Int
-	nft_share_received    *big.Int
-	nft_sold_received     *big.Int
-	nft_auctions_bids     *big.Int
-	nft_auctions_received *big.Int
+// Value is a SAVVA amount together with its fiat value at the time of the transactions
+type Value struct {
+	Savva   *big.Int
+	Fiat    float64
+	Records []Contribution // the records behind the value
 }
 
-func calcCounters(doc *pdf.Doc) *Counters {
-	c := &Counters{
-		savva_in:              new(big.Int),
-		savva_out:             new(big.Int),
-		donations_contribute:  new(big.Int),
-		donations_received:    new(big.Int),
-		fund_contributed:      new(big.Int),
-		fund_prizes_won:       new(big.Int),
-		staking_in:            new(big.Int),
-		staking_out:           new(big.Int),
-		staking_staked:        new(big.Int),
-		club_buy:              new(big.Int),
-		club_claimed:          new(big.Int),
-		fundrase_contributed:  new(big.Int),
-		fundrase_received:     new(big.Int),
-		paid_for_promotion:    new(big.Int),
-		nft_share_received:    new(big.Int),
-		nft_sold_received:     new(big.Int),
-		nft_auctions_bids:     new(big.Int),
-		nft_auctions_received: new(big.Int),
-	}
-
-	for _, h := range doc.History {
-
-		amount := new(big.Int)
-		if h.Amount != nil {
-			amount = h.Amount
-		}
-
-		if h.Contract.String == "token" && h.Type.String == "transfer" {
-			if h.FromAddr.String == doc.UserAddress {
-				c.savva_out = c.savva_out.Sub(c.savva_out, amount)
-			} else if h.ToAddr.String == doc.UserAddress {
-				c.savva_in = c.savva_in.Add(c.savva_in, amount)
-			}
-		}
-
-		if h.Contract.String == "fund" {
-			if h.Type.String == "donation" {
-				if h.FromAddr.String == doc.UserAddress {
-					c.donations_contribute = c.donations_contribute.Sub(c.donations_contribute, amount)
-				}
-
-				if h.ToAddr.String == doc.UserAddress {
-					c.donations_received = c.donations_received.Add(c.donations_received, amount)
-				}
-			}
-
-			if h.Type.String == "contribute" {
-				if h.FromAddr.String == doc.UserAddress {
-					c.fund_contributed = c.fund_contributed.Sub(c.fund_contributed, amount)
-				}
-			}
-
-			if h.Type.String == "prize" {
-				if h.ToAddr.String == doc.UserAddress {
-					c.fund_prizes_won = c.fund_prizes_won.Add(c.fund_prizes_won, amount)
-				}
-			}
-
-			if h.Type.String == "nft_share" {
-				if h.ToAddr.String == doc.UserAddress {
-					c.nft_share_received = c.nft_share_received.Add(c.nft_share_received, amount)
-				}
-			}
-		}
+// Contribution is a history record counted in the value
+type Contribution struct {
+	Record *data.HistoryRecord
+	Savva  *big.Int // signed as in the value
+	Fiat   float64
+}
 
-		if h.Contract.String == "staking" {
-			if h.Type.String == "transferred" {
-				if h.FromAddr.String == doc.UserAddress {
-					c.staking_out = c.staking_out.Sub(c.staking_out, amount)
-				} else if h.ToAddr.String == doc.UserAddress {
-					c.staking_in = c.staking_in.Add(c.staking_in, amount)
-				}
-			}
+func newValue() *Value {
+	return &Value{Savva: new(big.Int)}
+}
 
-			if h.Type.String == "staked" {
-				if h.FromAddr.String == doc.UserAddress {
-					c.staking_staked = c.staking_staked.Sub(c.staking_staked, amount)
-				}
-			}
+// Add adds the signed amount of the record and its fiat value
+func (v *Value) Add(h *data.HistoryRecord, amount *big.Int, fiat float64) {
+	v.Savva.Add(v.Savva, amount)
+	v.Fiat += fiat
+	v.Records = append(v.Records, Contribution{Record: h, Savva: amount, Fiat: fiat})
+}
 
-			if h.Type.String == "us_claimed" {
-				if h.ToAddr.String == doc.UserAddress {
-					c.staking_staked = c.staking_staked.Add(c.staking_staked, amount)
-				}
-			}
-		}
+// valuationTime is the end of the period or now if the period is not over yet
+func valuationTime(to time.Time) time.Time {
+	if now := time.Now(); now.Before(to) {
+		return now
+	}
+	return to
+}
 
-		if h.Contract.String == "club" {
-			if h.Type.String == "buy" {
-				if h.FromAddr.String == doc.UserAddress {
-					c.club_buy = c.club_buy.Sub(c.club_buy, amount)
-				}
-			}
+// Counters are the totals of the classification categories
+type Counters struct {
+	categories []Category
+	index      map[string]*Value
+}
 
-			if h.Type.String == "stopped" {
-				if h.ToAddr.String == doc.UserAddress {
-					c.club_buy = c.club_buy.Add(c.club_buy, amount)
-				}
-			}
+// Category is one row of the summary
+type Category struct {
+	Key   string
+	I18n  string // key of the description
+	Value *Value
+}
 
-			if h.Type.String == "claimed" {
-				if h.ToAddr.String == doc.UserAddress {
-					c.club_claimed = c.club_claimed.Add(c.club_claimed, amount)
-				}
-			}
-		}
+// Categories returns the counters in the order of the summary table
+func (c *Counters) Categories() []Category {
+	return c.categories
+}
 
-		if h.Contract.String == "fundraise" {
-			if h.Type.String == "contribution" {
-				if h.FromAddr.String == doc.UserAddress {
-					c.fundrase_contributed = c.fundrase_contributed.Sub(c.fundrase_contributed, amount)
-				}
-			}
+// classification returns the rules of the config or the default ones
+func classification(doc *pdf.Doc) *classify.Rules {
+	if doc.Config.Classification != nil {
+		return doc.Config.Classification
+	}
+	return classify.Default()
+}
 
-			if h.Type.String == "campaign_closed" {
-				if h.ToAddr.String == doc.UserAddress {
-					c.fundrase_received = c.fundrase_received.Add(c.fundrase_received, amount)
-				}
-			}
-		}
+// classifyRecord returns the category of the record and its amount signed for the category
+func classifyRecord(doc *pdf.Doc, h *data.HistoryRecord) (string, *big.Int) {
+	amount := new(big.Int)
+	if h.Amount != nil {
+		amount.Set(h.Amount)
+	}
 
-		if h.Contract.String == "promotion" {
-			if h.Type.String == "list_bought" {
-				if h.FromAddr.String == doc.UserAddress {
-					c.paid_for_promotion = c.paid_for_promotion.Sub(c.paid_for_promotion, amount)
-				}
-			}
-		}
+	out := strings.EqualFold(h.FromAddr.String, doc.UserAddress)
+	category, sign := classification(doc).Classify(h.Contract.String, h.Type.String, out)
+	if sign < 0 {
+		amount.Neg(amount)
+	}
 
-		if h.Contract.String == "nft_market" {
-			if h.Type.String == "NFTBought" {
-				if h.FromAddr.String == doc.UserAddress {
-					c.nft_sold_received = c.nft_sold_received.Sub(c.nft_sold_received, amount)
-				}
-			}
-		}
+	return category, amount
+}
 
-		if h.Contract.String == "auction" {
-			if h.Type.String == "bid" {
-				if h.FromAddr.String == doc.UserAddress {
-					c.nft_auctions_bids = c.nft_auctions_bids.Sub(c.nft_auctions_bids, amount)
-				}
-			}
+// calcCounters classifies the history records
+func calcCounters(doc *pdf.Doc, history []data.HistoryRecord) *Counters {
+	c := &Counters{index: make(map[string]*Value)}
+	for _, cat := range classification(doc).Categories {
+		v := newValue()
+		c.categories = append(c.categories, Category{Key: cat.Key, I18n: cat.I18n, Value: v})
+		c.index[cat.Key] = v
+	}
 
-			if h.Type.String == "bid_refund" {
-				if h.ToAddr.String == doc.UserAddress {
-					c.nft_auctions_bids = c.nft_auctions_bids.Add(c.nft_auctions_bids, amount)
-				}
-			}
+	// the records are kept in the chronological order
+	history = ledgerOrder(history)
+	for i := range history {
+		h := &history[i]
+		category, amount := classifyRecord(doc, h)
 
-			if h.Type.String == "finalized" {
-				if h.ToAddr.String == doc.UserAddress {
-					c.nft_auctions_received = c.nft_auctions_received.Add(c.nft_auctions_received, amount)
-				}
-			}
-		}
+		// the fiat value at the time of the transaction
+		c.index[category].Add(h, amount, doc.FiatAt(amount, h.TimeStamp))
 	}
 
 	return c
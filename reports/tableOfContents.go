@@ -7,20 +7,25 @@ import (
 )
 
 const INDENT = 15.
+const NUMBER_WIDTH = 20.
 
-var TEXT_LEFT = 30.
-var TEXT_WIDTH = 200.
-var NUMBER_RIGHT = 250.
-var NUMBER_WIDTH = 20.
+// tocLayout is the position of the TOC columns relative to the left margin
+type tocLayout struct {
+	textLeft    float64
+	textWidth   float64
+	numberRight float64
+}
 
 func addTableOfContents(doc *pdf.Doc) {
 
 	doc.PrintHeader = false
 	doc.NextPage()
 
-	TEXT_WIDTH = doc.GetMarginWidth() * 2 / 3
-	TEXT_LEFT = 20
-	NUMBER_RIGHT = doc.GetMarginWidth() - 20
+	l := &tocLayout{
+		textLeft:    20,
+		textWidth:   doc.GetMarginWidth() * 2 / 3,
+		numberRight: doc.GetMarginWidth() - 20,
+	}
 
 	// make sure it is even
 	if doc.CurentPage&1 == 0 {
@@ -43,21 +48,21 @@ func addTableOfContents(doc *pdf.Doc) {
 
 	for _, s := range doc.Sections {
 		indent += INDENT
-		TOCLine(doc, s, indent, &pdf.Style{
+		TOCLine(doc, l, s, indent, &pdf.Style{
 			FontName: "TimesBold",
 			FontSize: 14,
 			Align:    'L',
 		})
 		for _, ss := range s.SubSections {
 			indent += INDENT
-			TOCLine(doc, ss, indent, &pdf.Style{
+			TOCLine(doc, l, ss, indent, &pdf.Style{
 				FontName: "TimesBold",
 				FontSize: 14,
 				Align:    'L',
 			})
 			for _, sss := range ss.SubSections {
 				indent += INDENT
-				TOCLine(doc, sss, indent, &pdf.Style{
+				TOCLine(doc, l, sss, indent, &pdf.Style{
 					FontName: "Times",
 					FontSize: 14,
 					Align:    'L',
@@ -71,14 +76,15 @@ func addTableOfContents(doc *pdf.Doc) {
 	}
 }
 
-func TOCLine(doc *pdf.Doc, s *pdf.Section, indent float64, style *pdf.Style) {
+func TOCLine(doc *pdf.Doc, l *tocLayout, s *pdf.Section, indent float64, style *pdf.Style) {
 
 	doc.AssureVertialSpace(15)
 
-	t, w := doc.EclipseToWidthWithStyle(s.Title, TEXT_WIDTH-indent, style)
+	t, w := doc.EclipseToWidthWithStyle(s.Title, l.textWidth-indent, style)
 
-	doc.TextWidthStyle(t, doc.Margins.Left+TEXT_LEFT+indent, doc.GetY(), NUMBER_WIDTH, style)
-	doc.TextWidthStyle(fmt.Sprintf("%d", s.Page), doc.Margins.Left+NUMBER_RIGHT, doc.GetY(), NUMBER_WIDTH, &pdf.Style{
+	// the columns are mirrored in the right-to-left reports, the alignment too
+	doc.TextWidthStyle(t, doc.MirrorX(doc.Margins.Left+l.textLeft+indent, NUMBER_WIDTH), doc.GetY(), NUMBER_WIDTH, style)
+	doc.TextWidthStyle(fmt.Sprintf("%d", s.Page), doc.MirrorX(doc.Margins.Left+l.numberRight, NUMBER_WIDTH), doc.GetY(), NUMBER_WIDTH, &pdf.Style{
 		FontName: style.FontName,
 		FontSize: style.FontSize,
 		Align:    'R',
@@ -87,7 +93,7 @@ func TOCLine(doc *pdf.Doc, s *pdf.Section, indent float64, style *pdf.Style) {
 	// draw the grey line to the number
 	doc.SetLineWidth(0.5)
 	doc.SetStrokeColor(0xc4, 0xc4, 0xc4)
-	doc.Line(doc.Margins.Left+TEXT_LEFT+indent+w+2, doc.GetY(), doc.Margins.Left+NUMBER_RIGHT-2, doc.GetY())
+	doc.Line(doc.MirrorX(doc.Margins.Left+l.textLeft+indent+w+2, 0), doc.GetY(), doc.MirrorX(doc.Margins.Left+l.numberRight-2, 0), doc.GetY())
 
 	doc.NewLine()
 
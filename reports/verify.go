@@ -0,0 +1,174 @@
+package reports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/AlexNa-Holdings/savva-reports/chain"
+	"github.com/AlexNa-Holdings/savva-reports/i18n"
+	"github.com/AlexNa-Holdings/savva-reports/pdf"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const VERIFY_TIMEOUT = 2 * time.Minute
+
+const (
+	BALANCE_WALLET = "wallet"
+	BALANCE_STAKED = "staked"
+
+	BOUNDARY_OPENING = "opening"
+	BOUNDARY_CLOSING = "closing"
+)
+
+// Check compares one balance derived from the history with the contract
+type Check struct {
+	Balance  string // wallet or staked
+	Boundary string // opening or closing
+	Block    uint64
+	History  *big.Int
+	Chain    *big.Int
+}
+
+// Difference returns how much the contract differs from the history
+func (c *Check) Difference() *big.Int {
+	return new(big.Int).Sub(c.Chain, c.History)
+}
+
+// Verification is the result of the balance verification against the chain node
+type Verification struct {
+	Checks []Check
+}
+
+// Discrepancies returns the checks where the history does not match the contracts
+func (v *Verification) Discrepancies() []Check {
+	var r []Check
+	for _, c := range v.Checks {
+		if c.Difference().Sign() != 0 {
+			r = append(r, c)
+		}
+	}
+	return r
+}
+
+// closing returns the check of the balance at the end of the period, nil if the
+// balances were not verified
+func (v *Verification) closing(balance string) *Check {
+	if v == nil {
+		return nil
+	}
+	for i := range v.Checks {
+		if c := &v.Checks[i]; c.Balance == balance && c.Boundary == BOUNDARY_CLOSING {
+			return c
+		}
+	}
+	return nil
+}
+
+// verifyBalances queries balanceOf of the token and the staking contracts at the
+// last blocks before the start and before the end of the period
+func verifyBalances(doc *pdf.Doc, from, to time.Time, b *Balances) (*Verification, error) {
+	cfg := doc.Config
+	if cfg.Chain == nil {
+		return nil, errors.New("the chain node is not configured")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), VERIFY_TIMEOUT)
+	defer cancel()
+
+	account := common.HexToAddress(doc.UserAddress)
+	v := &Verification{}
+
+	boundaries := []struct {
+		name           string
+		t              time.Time
+		wallet, staked *big.Int
+	}{
+		{BOUNDARY_OPENING, from.Add(-time.Second), b.Wallet.Opening, b.Staked.Opening},
+		{BOUNDARY_CLOSING, to.Add(-time.Second), b.Wallet.Closing, b.Staked.Closing},
+	}
+
+	for _, boundary := range boundaries {
+		block, err := chain.BlockAt(ctx, cfg.Chain, boundary.t)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find the %s block: %w", boundary.name, err)
+		}
+
+		wallet, err := chain.BalanceOf(ctx, cfg.Chain, cfg.Contracts.SavvaToken, account, block.Number)
+		if err != nil {
+			return nil, err
+		}
+
+		staked, err := chain.BalanceOf(ctx, cfg.Chain, cfg.Contracts.Staking, account, block.Number)
+		if err != nil {
+			return nil, err
+		}
+
+		v.Checks = append(v.Checks,
+			Check{Balance: BALANCE_WALLET, Boundary: boundary.name, Block: block.Number.Uint64(), History: boundary.wallet, Chain: wallet},
+			Check{Balance: BALANCE_STAKED, Boundary: boundary.name, Block: block.Number.Uint64(), History: boundary.staked, Chain: staked},
+		)
+	}
+
+	return v, nil
+}
+
+// checkLabel describes the balance of the check, the keys are literal for check-translations
+func checkLabel(doc *pdf.Doc, c Check) string {
+	boundary := doc.T("verify.closing")
+	if c.Boundary == BOUNDARY_OPENING {
+		boundary = doc.T("verify.opening")
+	}
+
+	balance := doc.T("balance.staked")
+	if c.Balance == BALANCE_WALLET {
+		balance = doc.T("balance.wallet")
+	}
+	return boundary + ": " + balance
+}
+
+func addVerification(doc *pdf.Doc, v *Verification) {
+	doc.NewSubSection(doc.T("verify.title"))
+	doc.MarkDownToPdf(doc.T("verify.introduction"))
+	doc.NewLine()
+
+	discrepancies := v.Discrepancies()
+	if len(discrepancies) > 0 {
+		doc.WarningBox(doc.Tf("verify.discrepancy", i18n.Args{"count": len(discrepancies)}))
+	}
+
+	t := doc.NewTable()
+	t.CellStyle.FontSize = 10
+	t.SetHeader(doc.T("description"), doc.T("verify.block"), doc.T("verify.history"), doc.T("verify.chain"), doc.T("balance.difference"))
+	t.ColWidths = []float64{0, 65, 90, 90, 80}
+
+	for i := 1; i < 5; i++ {
+		t.ColStyle[i].Align = 'R'
+	}
+
+	for _, c := range v.Checks {
+		t.AddRow(
+			checkLabel(doc, c),
+			fmt.Sprint(c.Block),
+			doc.FormatValue(c.History, 18),
+			doc.FormatValue(c.Chain, 18),
+			doc.FormatValue(c.Difference(), 18),
+		)
+	}
+
+	t.OnBeforeDrawCell = func(t *pdf.Table, r, col int, x, y, w, h float64, text string, style *pdf.Style) {
+		if col != 4 {
+			return
+		}
+
+		style.FontName, style.FontColor = t.CellStyle.FontName, t.CellStyle.FontColor
+		if v.Checks[r].Difference().Sign() != 0 {
+			style.FontName = "DejaVuBold"
+			style.FontColor = &pdf.WARNING_COLOR
+		}
+	}
+
+	doc.WriteTable(t)
+}
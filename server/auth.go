@@ -0,0 +1,290 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+var errTooManyNonces = errors.New("too many sign in attempts, try again later")
+
+type AuthConfig struct {
+	Domain        string        `yaml:"domain"`          // expected domain of the SIWE message
+	URI           string        `yaml:"uri"`             // expected URI of the SIWE message
+	ChainID       uint64        `yaml:"chain_id"`        // expected Chain ID of the SIWE message
+	Admins        []string      `yaml:"admins"`          // addresses allowed to fetch any report
+	NonceTTL      time.Duration `yaml:"nonce_ttl"`       // time to sign in after the nonce is issued
+	MessageMaxAge time.Duration `yaml:"message_max_age"` // max age of the "Issued At" of the message
+	SessionTTL    time.Duration `yaml:"session_ttl"`
+}
+
+// Validate checks the fields the signed messages are verified against
+func (c *AuthConfig) Validate() error {
+	var errs []error
+
+	if c.Domain == "" {
+		errs = append(errs, errors.New("auth.domain is not set"))
+	}
+
+	if c.URI == "" {
+		errs = append(errs, errors.New("auth.uri is not set"))
+	} else if u, err := url.Parse(c.URI); err != nil || !u.IsAbs() {
+		errs = append(errs, fmt.Errorf("auth.uri must be an absolute URI: %s", c.URI))
+	}
+
+	if c.ChainID == 0 {
+		errs = append(errs, errors.New("auth.chain_id is not set"))
+	}
+
+	return errors.Join(errs...)
+}
+
+type session struct {
+	address   common.Address
+	admin     bool
+	expiresAt time.Time
+}
+
+// canAccess reports whether the session may fetch the reports of the address
+func (s *session) canAccess(address string) bool {
+	return s.admin || s.address == common.HexToAddress(address)
+}
+
+type authStore struct {
+	config   AuthConfig
+	admins   map[common.Address]bool
+	mu       sync.Mutex
+	nonces   map[string]time.Time // nonce -> expiration
+	sessions map[string]*session  // token -> session
+}
+
+func newAuthStore(config AuthConfig) *authStore {
+	if config.NonceTTL == 0 {
+		config.NonceTTL = 10 * time.Minute
+	}
+	if config.MessageMaxAge == 0 {
+		config.MessageMaxAge = 10 * time.Minute
+	}
+	if config.SessionTTL == 0 {
+		config.SessionTTL = 12 * time.Hour
+	}
+
+	a := &authStore{
+		config:   config,
+		admins:   make(map[common.Address]bool),
+		nonces:   make(map[string]time.Time),
+		sessions: make(map[string]*session),
+	}
+
+	for _, addr := range config.Admins {
+		if !common.IsHexAddress(addr) {
+			log.Error().Msgf("Invalid admin address %q ignored", addr)
+			continue
+		}
+		a.admins[common.HexToAddress(addr)] = true
+	}
+
+	return a
+}
+
+// expire removes the expired nonces and sessions. Must be called with the lock held
+func (a *authStore) expire(now time.Time) {
+	for n, exp := range a.nonces {
+		if now.After(exp) {
+			delete(a.nonces, n)
+		}
+	}
+	for t, s := range a.sessions {
+		if now.After(s.expiresAt) {
+			delete(a.sessions, t)
+		}
+	}
+}
+
+// newNonce issues a nonce unless MAX_NONCES are waiting to be used already, anybody can
+// ask for them
+func (a *authStore) newNonce() (string, time.Time, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := time.Now()
+	a.expire(now)
+
+	if len(a.nonces) >= MAX_NONCES {
+		return "", time.Time{}, errTooManyNonces
+	}
+
+	nonce := randomID(16)
+	exp := now.Add(a.config.NonceTTL)
+	a.nonces[nonce] = exp
+	return nonce, exp, nil
+}
+
+// useNonce consumes the nonce, so every signed message can be used only once
+func (a *authStore) useNonce(nonce string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	exp, ok := a.nonces[nonce]
+	if !ok {
+		return false
+	}
+	delete(a.nonces, nonce)
+	return time.Now().Before(exp)
+}
+
+// login verifies the signed message and starts a session for the signer
+func (a *authStore) login(message, signature string) (string, *session, error) {
+	m, err := ParseSiweMessage(message)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if m.Domain != a.config.Domain {
+		return "", nil, errors.New("siwe: domain mismatch")
+	}
+
+	if m.URI != a.config.URI {
+		return "", nil, errors.New("siwe: URI mismatch")
+	}
+
+	if m.ChainID != strconv.FormatUint(a.config.ChainID, 10) {
+		return "", nil, errors.New("siwe: Chain ID mismatch")
+	}
+
+	now := time.Now()
+	if err := m.CheckTime(now, a.config.MessageMaxAge); err != nil {
+		return "", nil, err
+	}
+
+	signer, err := RecoverSigner(message, signature)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if signer != m.Address {
+		return "", nil, errors.New("siwe: signature does not match the address")
+	}
+
+	// consume the nonce only after the signature is verified, so nobody can burn other's nonces
+	if !a.useNonce(m.Nonce) {
+		return "", nil, errors.New("siwe: unknown or expired nonce")
+	}
+
+	s := &session{
+		address:   signer,
+		admin:     a.admins[signer],
+		expiresAt: now.Add(a.config.SessionTTL),
+	}
+	if m.ExpirationTime != nil && m.ExpirationTime.Before(s.expiresAt) {
+		s.expiresAt = *m.ExpirationTime
+	}
+
+	token := randomID(32)
+
+	a.mu.Lock()
+	a.sessions[token] = s
+	a.mu.Unlock()
+
+	return token, s, nil
+}
+
+func (a *authStore) logout(token string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	delete(a.sessions, token)
+}
+
+func (a *authStore) getSession(token string) (*session, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	s, ok := a.sessions[token]
+	if !ok || time.Now().After(s.expiresAt) {
+		return nil, false
+	}
+	return s, true
+}
+
+func bearerToken(r *http.Request) string {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+type sessionKey struct{}
+
+func sessionFrom(ctx context.Context) *session {
+	s, _ := ctx.Value(sessionKey{}).(*session)
+	return s
+}
+
+// authenticated rejects the requests without a valid session
+func (s *Server) authenticated(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		sess, ok := s.auth.getSession(bearerToken(r))
+		if !ok {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(w, http.StatusUnauthorized, errors.New("sign in required"))
+			return
+		}
+
+		h(w, r.WithContext(context.WithValue(r.Context(), sessionKey{}, sess)))
+	}
+}
+
+func (s *Server) handleNonce(w http.ResponseWriter, r *http.Request) {
+	nonce, exp, err := s.auth.newNonce()
+	if err != nil {
+		writeError(w, http.StatusTooManyRequests, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"nonce":      nonce,
+		"expires_at": exp.UTC(),
+	})
+}
+
+func (s *Server) handleLogin(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Message   string `json:"message"`
+		Signature string `json:"signature"`
+	}
+
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 16*1024)).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, errors.New("invalid request body"))
+		return
+	}
+
+	token, sess, err := s.auth.login(req.Message, req.Signature)
+	if err != nil {
+		log.Info().Err(err).Msg("Sign in rejected")
+		writeError(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"token":      token,
+		"address":    sess.address.Hex(),
+		"admin":      sess.admin,
+		"expires_at": sess.expiresAt.UTC(),
+	})
+}
+
+func (s *Server) handleLogout(w http.ResponseWriter, r *http.Request) {
+	s.auth.logout(bearerToken(r))
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,209 @@
+package server
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// another account of the test nodes
+const OTHER_KEY = "59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d"
+
+func testAuthStore() *authStore {
+	return newAuthStore(AuthConfig{
+		Domain:  TEST_DOMAIN,
+		URI:     TEST_URI,
+		ChainID: TEST_CHAIN_ID,
+		Admins:  []string{TEST_ADDRESS},
+	})
+}
+
+func TestLogin(t *testing.T) {
+	key := testKey(t, TEST_KEY)
+	other := testKey(t, OTHER_KEY)
+	now := time.Now().UTC()
+
+	tests := []struct {
+		name   string
+		domain string // of the header, empty for TEST_DOMAIN
+		fields []string
+		signer bool // signed by the other key
+		nonce  func(a *authStore) string
+		want   string // part of the error, empty if signed in
+	}{
+		{"signed in", "", nil, false, nil, ""},
+		{"wrong domain", "evil.app", nil, false, nil, "domain mismatch"},
+		{"subdomain", "reports.savva.app", nil, false, nil, "domain mismatch"},
+		{"wrong uri", "", []string{"URI: https://evil.app"}, false, nil, "URI mismatch"},
+		{"uri path", "", []string{"URI: https://savva.app/login"}, false, nil, "URI mismatch"},
+		{"wrong chain id", "", []string{"Chain ID: 1"}, false, nil, "Chain ID mismatch"},
+		{"other signer", "", nil, true, nil, "does not match"},
+		{"expired message", "", []string{"Expiration Time: " + now.Add(-time.Second).Format(time.RFC3339)}, false, nil, "expired"},
+		{"not valid yet", "", []string{"Not Before: " + now.Add(time.Hour).Format(time.RFC3339)}, false, nil, "not valid yet"},
+		{"old message", "", []string{"Issued At: " + now.Add(-time.Hour).Format(time.RFC3339)}, false, nil, "too old"},
+		{"future message", "", []string{"Issued At: " + now.Add(time.Hour).Format(time.RFC3339)}, false, nil, "in the future"},
+		{"unknown nonce", "", nil, false, func(a *authStore) string { return "not-issued" }, "unknown or expired nonce"},
+		{"expired nonce", "", nil, false, func(a *authStore) string {
+			nonce, _, _ := a.newNonce()
+			a.nonces[nonce] = time.Now().Add(-time.Second)
+			return nonce
+		}, "unknown or expired nonce"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := testAuthStore()
+
+			nonce := ""
+			if tt.nonce != nil {
+				nonce = tt.nonce(a)
+			} else {
+				nonce, _, _ = a.newNonce()
+			}
+
+			fields := append([]string{"Nonce: " + nonce, "Issued At: " + now.Format(time.RFC3339)}, tt.fields...)
+			message := siweMessage(TEST_ADDRESS, fields...)
+			if tt.domain != "" {
+				message = strings.Replace(message, TEST_DOMAIN+SIWE_HEADER_SUFFIX, tt.domain+SIWE_HEADER_SUFFIX, 1)
+			}
+
+			signer := key
+			if tt.signer {
+				signer = other
+			}
+
+			token, s, err := a.login(message, sign(t, signer, message))
+			if tt.want != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.want) {
+					t.Errorf("login() error = %v, want %q", err, tt.want)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("login() error = %v", err)
+			}
+			if s.address != common.HexToAddress(TEST_ADDRESS) || !s.admin {
+				t.Errorf("login() session = %+v", s)
+			}
+			if got, ok := a.getSession(token); !ok || got != s {
+				t.Errorf("getSession(%q) = %v, %v", token, got, ok)
+			}
+		})
+	}
+}
+
+func TestLoginReplay(t *testing.T) {
+	a := testAuthStore()
+	key := testKey(t, TEST_KEY)
+
+	nonce, _, _ := a.newNonce()
+	message := siweMessage(TEST_ADDRESS, "Nonce: "+nonce, "Issued At: "+time.Now().UTC().Format(time.RFC3339))
+	signature := sign(t, key, message)
+
+	if _, _, err := a.login(message, signature); err != nil {
+		t.Fatalf("login() error = %v", err)
+	}
+	if _, _, err := a.login(message, signature); err == nil || !strings.Contains(err.Error(), "nonce") {
+		t.Errorf("login() replayed error = %v, want the nonce rejected", err)
+	}
+}
+
+func TestLoginSessionExpiration(t *testing.T) {
+	a := testAuthStore()
+	key := testKey(t, TEST_KEY)
+
+	expires := time.Now().UTC().Add(time.Minute).Truncate(time.Second)
+	nonce, _, _ := a.newNonce()
+	message := siweMessage(TEST_ADDRESS, "Nonce: "+nonce, "Issued At: "+time.Now().UTC().Format(time.RFC3339),
+		"Expiration Time: "+expires.Format(time.RFC3339))
+
+	token, s, err := a.login(message, sign(t, key, message))
+	if err != nil {
+		t.Fatalf("login() error = %v", err)
+	}
+	if !s.expiresAt.Equal(expires) {
+		t.Errorf("session expires at %v, want the Expiration Time %v", s.expiresAt, expires)
+	}
+
+	s.expiresAt = time.Now().Add(-time.Second)
+	if _, ok := a.getSession(token); ok {
+		t.Error("getSession() returned an expired session")
+	}
+}
+
+func TestNonceLimit(t *testing.T) {
+	a := testAuthStore()
+
+	for i := 0; i < MAX_NONCES; i++ {
+		if _, _, err := a.newNonce(); err != nil {
+			t.Fatalf("newNonce() %d error = %v", i, err)
+		}
+	}
+	if _, _, err := a.newNonce(); err != errTooManyNonces {
+		t.Fatalf("newNonce() over the limit error = %v, want %v", err, errTooManyNonces)
+	}
+
+	// the expired nonces make room for the new ones
+	for n := range a.nonces {
+		a.nonces[n] = time.Now().Add(-time.Second)
+		break
+	}
+	if _, _, err := a.newNonce(); err != nil {
+		t.Errorf("newNonce() after an expiration error = %v", err)
+	}
+}
+
+func TestCanAccess(t *testing.T) {
+	owner := crypto.PubkeyToAddress(testKey(t, OTHER_KEY).PublicKey)
+
+	tests := []struct {
+		name    string
+		session session
+		address string
+		want    bool
+	}{
+		{"owner", session{address: owner}, owner.Hex(), true},
+		{"owner lower case", session{address: owner}, strings.ToLower(owner.Hex()), true},
+		{"other user", session{address: owner}, TEST_ADDRESS, false},
+		{"admin", session{address: common.HexToAddress(TEST_ADDRESS), admin: true}, owner.Hex(), true},
+		{"admin own", session{address: common.HexToAddress(TEST_ADDRESS), admin: true}, TEST_ADDRESS, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.session.canAccess(tt.address); got != tt.want {
+				t.Errorf("canAccess(%s) = %v, want %v", tt.address, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config AuthConfig
+		want   []string // parts of the error, none if valid
+	}{
+		{"valid", AuthConfig{Domain: TEST_DOMAIN, URI: TEST_URI, ChainID: TEST_CHAIN_ID}, nil},
+		{"empty", AuthConfig{}, []string{"auth.domain", "auth.uri", "auth.chain_id"}},
+		{"relative uri", AuthConfig{Domain: TEST_DOMAIN, URI: "savva.app", ChainID: TEST_CHAIN_ID}, []string{"absolute URI"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if len(tt.want) == 0 && err != nil {
+				t.Errorf("Validate() error = %v", err)
+			}
+			for _, want := range tt.want {
+				if err == nil || !strings.Contains(err.Error(), want) {
+					t.Errorf("Validate() error = %v, want %q", err, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package server
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/AlexNa-Holdings/savva-reports/period"
+)
+
+const (
+	JOB_PENDING = "pending"
+	JOB_RUNNING = "running"
+	JOB_DONE    = "done"
+	JOB_FAILED  = "failed"
+)
+
+var errTooManyJobs = errors.New("too many reports are being rendered, try again later")
+
+// Job is a report rendered in the background
+type Job struct {
+	ID         string     `json:"id"`
+	Address    string     `json:"address"`
+	Period     string     `json:"period"`
+	Locale     string     `json:"locale"`
+	Currency   string     `json:"currency"`
+	Format     string     `json:"format"`
+	Explain    bool       `json:"explain,omitempty"`
+	Verify     bool       `json:"verify,omitempty"`
+	Timezone   string     `json:"timezone"`
+	Status     string     `json:"status"`
+	Error      string     `json:"error,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+
+	period  period.Period
+	content []byte
+}
+
+// jobStore keeps the jobs in memory until they expire
+type jobStore struct {
+	mu      sync.Mutex
+	jobs    map[string]*Job
+	ttl     time.Duration
+	renders int // synchronous renders, see startRender
+	maxBusy int // pending and running jobs and synchronous renders at most
+}
+
+func newJobStore(ttl time.Duration, maxBusy int) *jobStore {
+	return &jobStore{
+		jobs:    make(map[string]*Job),
+		ttl:     ttl,
+		maxBusy: maxBusy,
+	}
+}
+
+// randomID returns n random bytes in hex
+func randomID(n int) string {
+	b := make([]byte, n)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// add stores the job unless there are too many unfinished ones already
+func (s *jobStore) add(j *Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.expire()
+
+	if s.busy() >= s.maxBusy {
+		return errTooManyJobs
+	}
+
+	s.jobs[j.ID] = j
+	return nil
+}
+
+// startRender takes a place of a job for a report rendered while the client waits,
+// endRender must be called when it is done
+func (s *jobStore) startRender() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.busy() >= s.maxBusy {
+		return errTooManyJobs
+	}
+
+	s.renders++
+	return nil
+}
+
+func (s *jobStore) endRender() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.renders--
+}
+
+// busy returns the number of the unfinished jobs and renders. Must be called with the lock held
+func (s *jobStore) busy() int {
+	busy := s.renders
+	for _, job := range s.jobs {
+		if job.FinishedAt == nil {
+			busy++
+		}
+	}
+	return busy
+}
+
+// get returns a copy of the job, so it can be read while the job is running
+func (s *jobStore) get(id string) (Job, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	j, ok := s.jobs[id]
+	if !ok {
+		return Job{}, false
+	}
+	return *j, true
+}
+
+func (s *jobStore) setStatus(id, status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if j, ok := s.jobs[id]; ok {
+		j.Status = status
+	}
+}
+
+func (s *jobStore) finish(id string, content *bytes.Buffer, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	j, ok := s.jobs[id]
+	if !ok {
+		return
+	}
+
+	now := time.Now().UTC()
+	j.FinishedAt = &now
+
+	if err != nil {
+		j.Status = JOB_FAILED
+		j.Error = errBuildFailed.Error() // the details are in the log only
+		return
+	}
+
+	j.Status = JOB_DONE
+	j.content = content.Bytes()
+}
+
+// expire removes the finished jobs older than ttl. Must be called with the lock held
+func (s *jobStore) expire() {
+	for id, j := range s.jobs {
+		if j.FinishedAt != nil && time.Since(*j.FinishedAt) > s.ttl {
+			delete(s.jobs, id)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package server
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestJobStoreLimit(t *testing.T) {
+	s := newJobStore(time.Hour, 2)
+
+	if err := s.add(&Job{ID: "a"}); err != nil {
+		t.Fatalf("add() error = %v", err)
+	}
+	if err := s.startRender(); err != nil {
+		t.Fatalf("startRender() error = %v", err)
+	}
+
+	// the jobs and the synchronous renders share the limit
+	if err := s.add(&Job{ID: "b"}); err != errTooManyJobs {
+		t.Errorf("add() over the limit error = %v, want %v", err, errTooManyJobs)
+	}
+	if err := s.startRender(); err != errTooManyJobs {
+		t.Errorf("startRender() over the limit error = %v, want %v", err, errTooManyJobs)
+	}
+
+	s.endRender()
+	if err := s.startRender(); err != nil {
+		t.Errorf("startRender() after endRender() error = %v", err)
+	}
+
+	s.finish("a", &bytes.Buffer{}, nil)
+	if err := s.add(&Job{ID: "b"}); err != nil {
+		t.Errorf("add() after a finished job error = %v", err)
+	}
+}
@@ -0,0 +1,365 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/AlexNa-Holdings/savva-reports/cmn"
+	"github.com/AlexNa-Holdings/savva-reports/currency"
+	"github.com/AlexNa-Holdings/savva-reports/period"
+	"github.com/AlexNa-Holdings/savva-reports/reports"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	JOB_TTL  = time.Hour
+	MAX_JOBS = 4 // rendered at the same time, in the background or while the client waits
+
+	MAX_NONCES = 10000 // issued and not used yet, see authStore.newNonce
+)
+
+var (
+	errForbidden   = errors.New("the report belongs to another address")
+	errBuildFailed = errors.New("failed to build report")
+)
+
+type Server struct {
+	cfg  *cmn.Config
+	jobs *jobStore
+	auth *authStore
+	mux  *http.ServeMux
+}
+
+// New creates the report service. Reports are served only to the signed in
+// owner of the address or to the admins listed in the auth config.
+func New(cfg *cmn.Config, auth AuthConfig) *Server {
+	s := &Server{
+		cfg:  cfg,
+		jobs: newJobStore(JOB_TTL, MAX_JOBS),
+		auth: newAuthStore(auth),
+		mux:  http.NewServeMux(),
+	}
+
+	s.mux.HandleFunc("GET /healthz", s.handleHealth)
+	s.mux.HandleFunc("GET /auth/nonce", s.handleNonce)
+	s.mux.HandleFunc("POST /auth/login", s.handleLogin)
+	s.mux.HandleFunc("POST /auth/logout", s.handleLogout)
+	s.mux.HandleFunc("GET /reports/{address}/{period}", s.authenticated(s.handleReport))
+	s.mux.HandleFunc("GET /reports/monthly/{address}/{year}/{month}", s.authenticated(s.handleReport))
+	s.mux.HandleFunc("POST /jobs/{address}/{period}", s.authenticated(s.handleCreateJob))
+	s.mux.HandleFunc("POST /jobs/monthly/{address}/{year}/{month}", s.authenticated(s.handleCreateJob))
+	s.mux.HandleFunc("GET /jobs/{id}", s.authenticated(s.handleJobStatus))
+	s.mux.HandleFunc("GET /jobs/{id}/report", s.authenticated(s.handleJobReport))
+	s.mux.HandleFunc("GET /jobs/{id}/pdf", s.authenticated(s.handleJobReport)) // before the exports were added
+	s.mux.HandleFunc("GET /schemas/monthly-v1.json", handleSchema(reports.MonthlySchema))
+	s.mux.HandleFunc("GET /schemas/explain-v1.json", handleSchema(reports.ExplainSchema))
+
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// ListenAndServe serves the API until the server fails
+func (s *Server) ListenAndServe(addr string) error {
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           s,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	log.Info().Msgf("Report service listening on %s", addr)
+	return srv.ListenAndServe()
+}
+
+type reportRequest struct {
+	address  string
+	period   period.Period
+	locale   string
+	currency string
+	format   string
+	explain  bool
+	verify   bool
+	timezone string
+}
+
+func (rr *reportRequest) options() reports.Options {
+	return reports.Options{
+		Locale:   rr.locale,
+		Currency: rr.currency,
+		Explain:  rr.explain,
+		Verify:   rr.verify,
+		Timezone: rr.timezone,
+	}
+}
+
+func (s *Server) parseReportRequest(r *http.Request) (*reportRequest, error) {
+	address, err := cmn.ParseAddress(r.PathValue("address"))
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := parsePeriod(r)
+	if err != nil {
+		return nil, err
+	}
+
+	locale := r.URL.Query().Get("locale")
+	if locale == "" {
+		locale = "en"
+	}
+	if !s.cfg.Locales().Has(locale) {
+		return nil, fmt.Errorf("unsupported locale %q", locale)
+	}
+
+	code := r.URL.Query().Get("currency")
+	if code == "" {
+		code = s.cfg.Currency
+	}
+	cur, err := currency.Get(code)
+	if err != nil {
+		return nil, err
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = reports.FORMAT_PDF
+	}
+	if err := reports.CheckFormat(format); err != nil {
+		return nil, err
+	}
+
+	explain := false
+	if v := r.URL.Query().Get("explain"); v != "" {
+		explain, err = strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid explain %q", v)
+		}
+	}
+
+	verify := false
+	if v := r.URL.Query().Get("verify"); v != "" {
+		verify, err = strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid verify %q", v)
+		}
+		if verify && s.cfg.Chain == nil {
+			return nil, errors.New("the chain node is not configured, verify is not available")
+		}
+	}
+
+	loc, err := time.LoadLocation(r.URL.Query().Get("timezone"))
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %q", r.URL.Query().Get("timezone"))
+	}
+
+	return &reportRequest{
+		address:  address,
+		period:   p,
+		locale:   locale,
+		currency: cur.Code,
+		format:   format,
+		explain:  explain,
+		verify:   verify,
+		timezone: loc.String(),
+	}, nil
+}
+
+// parsePeriod returns the period of the path, the monthly routes have the year and the month
+func parsePeriod(r *http.Request) (period.Period, error) {
+	if s := r.PathValue("period"); s != "" {
+		return period.Parse(s)
+	}
+
+	year, err := strconv.Atoi(r.PathValue("year"))
+	if err != nil || year < period.MIN_YEAR || year > period.MAX_YEAR {
+		return period.Period{}, fmt.Errorf("invalid year %q", r.PathValue("year"))
+	}
+
+	month, err := strconv.Atoi(r.PathValue("month"))
+	if err != nil || month < 1 || month > 12 {
+		return period.Period{}, fmt.Errorf("invalid month %q", r.PathValue("month"))
+	}
+
+	return period.Month(year, month), nil
+}
+
+func (rr *reportRequest) fileName() string {
+	return fmt.Sprintf("%s-%s-%s%s", rr.address, rr.period.Slug(), rr.locale, reports.Extensions[rr.format])
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Error().Err(err).Msg("Failed to write response")
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+var contentTypes = map[string]string{
+	reports.FORMAT_PDF:     "application/pdf",
+	reports.FORMAT_CSV:     "text/csv; charset=utf-8",
+	reports.FORMAT_JSON:    "application/json",
+	reports.FORMAT_EXPLAIN: "application/json",
+}
+
+func writeReport(w http.ResponseWriter, rr *reportRequest, content []byte) {
+	w.Header().Set("Content-Type", contentTypes[rr.format])
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`inline; filename="%s"`, rr.fileName()))
+	w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+	if _, err := w.Write(content); err != nil {
+		log.Error().Err(err).Msg("Failed to stream report")
+	}
+}
+
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	if s.cfg.DB == nil { // fixtures
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+		return
+	}
+
+	if err := s.cfg.DB.PingContext(r.Context()); err != nil {
+		writeError(w, http.StatusServiceUnavailable, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func handleSchema(schema []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/schema+json")
+		w.Write(schema)
+	}
+}
+
+// handleReport renders the report while the client waits
+func (s *Server) handleReport(w http.ResponseWriter, r *http.Request) {
+	rr, err := s.parseReportRequest(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if !sessionFrom(r.Context()).canAccess(rr.address) {
+		writeError(w, http.StatusForbidden, errForbidden)
+		return
+	}
+
+	if err := s.jobs.startRender(); err != nil {
+		writeError(w, http.StatusTooManyRequests, err)
+		return
+	}
+	defer s.jobs.endRender()
+
+	// render to memory first, so a failure can still be reported with a proper status
+	var buf bytes.Buffer
+	if err := reports.WriteReport(&buf, s.cfg, rr.address, rr.period, rr.format, rr.options()); err != nil {
+		log.Error().Err(err).Msgf("Failed to build report for %s", rr.address)
+		writeError(w, http.StatusInternalServerError, errBuildFailed)
+		return
+	}
+
+	writeReport(w, rr, buf.Bytes())
+}
+
+// handleCreateJob starts rendering in the background and returns the job to poll
+func (s *Server) handleCreateJob(w http.ResponseWriter, r *http.Request) {
+	rr, err := s.parseReportRequest(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if !sessionFrom(r.Context()).canAccess(rr.address) {
+		writeError(w, http.StatusForbidden, errForbidden)
+		return
+	}
+
+	job := &Job{
+		ID:        randomID(16),
+		Address:   rr.address,
+		Period:    rr.period.String(),
+		period:    rr.period,
+		Locale:    rr.locale,
+		Currency:  rr.currency,
+		Format:    rr.format,
+		Explain:   rr.explain,
+		Verify:    rr.verify,
+		Timezone:  rr.timezone,
+		Status:    JOB_PENDING,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.jobs.add(job); err != nil {
+		writeError(w, http.StatusTooManyRequests, err)
+		return
+	}
+
+	// the response is encoded from a copy, the job changes while it is rendered
+	accepted := *job
+
+	go func() {
+		s.jobs.setStatus(job.ID, JOB_RUNNING)
+
+		var buf bytes.Buffer
+		err := reports.WriteReport(&buf, s.cfg, rr.address, rr.period, rr.format, rr.options())
+		if err != nil {
+			log.Error().Err(err).Msgf("Job %s failed", job.ID)
+		}
+		s.jobs.finish(job.ID, &buf, err)
+	}()
+
+	w.Header().Set("Location", "/jobs/"+job.ID)
+	writeJSON(w, http.StatusAccepted, accepted)
+}
+
+// getJob returns the job if it exists and the session may see it
+func (s *Server) getJob(r *http.Request) (Job, bool) {
+	job, ok := s.jobs.get(r.PathValue("id"))
+	if !ok {
+		return Job{}, false
+	}
+
+	if !sessionFrom(r.Context()).canAccess(job.Address) {
+		return Job{}, false // do not reveal the jobs of the others
+	}
+
+	return job, true
+}
+
+func (s *Server) handleJobStatus(w http.ResponseWriter, r *http.Request) {
+	job, ok := s.getJob(r)
+	if !ok {
+		writeError(w, http.StatusNotFound, errors.New("job not found"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, job)
+}
+
+func (s *Server) handleJobReport(w http.ResponseWriter, r *http.Request) {
+	job, ok := s.getJob(r)
+	if !ok {
+		writeError(w, http.StatusNotFound, errors.New("job not found"))
+		return
+	}
+
+	switch job.Status {
+	case JOB_DONE:
+		rr := reportRequest{address: job.Address, period: job.period, locale: job.Locale, format: job.Format}
+		writeReport(w, &rr, job.content)
+	case JOB_FAILED:
+		writeError(w, http.StatusInternalServerError, errBuildFailed)
+	default:
+		writeError(w, http.StatusConflict, fmt.Errorf("job is %s", job.Status))
+	}
+}
@@ -0,0 +1,158 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const SIWE_HEADER_SUFFIX = " wants you to sign in with your Ethereum account:"
+
+// SiweMessage is a parsed EIP-4361 (Sign-In with Ethereum) message
+type SiweMessage struct {
+	Domain         string
+	Address        common.Address
+	Statement      string
+	URI            string
+	Version        string
+	ChainID        string
+	Nonce          string
+	IssuedAt       time.Time
+	ExpirationTime *time.Time
+	NotBefore      *time.Time
+}
+
+// ParseSiweMessage parses the text of an EIP-4361 message
+func ParseSiweMessage(text string) (*SiweMessage, error) {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	if len(lines) < 2 {
+		return nil, errors.New("siwe: message is too short")
+	}
+
+	m := &SiweMessage{}
+
+	domain, ok := strings.CutSuffix(lines[0], SIWE_HEADER_SUFFIX)
+	if !ok || domain == "" {
+		return nil, errors.New("siwe: invalid header")
+	}
+	m.Domain = domain
+
+	if !common.IsHexAddress(lines[1]) {
+		return nil, fmt.Errorf("siwe: invalid address %q", lines[1])
+	}
+	m.Address = common.HexToAddress(lines[1])
+
+	// the optional statement is surrounded by empty lines
+	i := 2
+	if i+1 < len(lines) && lines[i] == "" && lines[i+1] != "" && !strings.HasPrefix(lines[i+1], "URI: ") {
+		m.Statement = lines[i+1]
+		i += 2
+	}
+
+	fields := make(map[string]string)
+	for ; i < len(lines); i++ {
+		if lines[i] == "" {
+			continue
+		}
+		if lines[i] == "Resources:" {
+			break // resources are not used
+		}
+		key, value, ok := strings.Cut(lines[i], ": ")
+		if !ok {
+			return nil, fmt.Errorf("siwe: invalid line %q", lines[i])
+		}
+		fields[key] = value
+	}
+
+	m.URI = fields["URI"]
+	m.Version = fields["Version"]
+	m.ChainID = fields["Chain ID"]
+	m.Nonce = fields["Nonce"]
+
+	if m.URI == "" || m.Nonce == "" || m.ChainID == "" {
+		return nil, errors.New("siwe: URI, Chain ID and Nonce are required")
+	}
+
+	if m.Version != "1" {
+		return nil, fmt.Errorf("siwe: unsupported version %q", m.Version)
+	}
+
+	var err error
+	m.IssuedAt, err = time.Parse(time.RFC3339, fields["Issued At"])
+	if err != nil {
+		return nil, fmt.Errorf("siwe: invalid Issued At: %w", err)
+	}
+
+	if v, ok := fields["Expiration Time"]; ok {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("siwe: invalid Expiration Time: %w", err)
+		}
+		m.ExpirationTime = &t
+	}
+
+	if v, ok := fields["Not Before"]; ok {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("siwe: invalid Not Before: %w", err)
+		}
+		m.NotBefore = &t
+	}
+
+	return m, nil
+}
+
+// CheckTime verifies that the message is valid at the time now
+func (m *SiweMessage) CheckTime(now time.Time, maxAge time.Duration) error {
+	if m.ExpirationTime != nil && now.After(*m.ExpirationTime) {
+		return errors.New("siwe: message expired")
+	}
+
+	if m.NotBefore != nil && now.Before(*m.NotBefore) {
+		return errors.New("siwe: message is not valid yet")
+	}
+
+	if now.Sub(m.IssuedAt) > maxAge {
+		return errors.New("siwe: message is too old")
+	}
+
+	if m.IssuedAt.Sub(now) > time.Minute { // allow a small clock skew
+		return errors.New("siwe: message is issued in the future")
+	}
+
+	return nil
+}
+
+// textHash is the EIP-191 hash of a personal message
+func textHash(text string) []byte {
+	return crypto.Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(text), text)))
+}
+
+// RecoverSigner returns the address that signed the text with personal_sign (EIP-191)
+func RecoverSigner(text, signature string) (common.Address, error) {
+	sig, err := hexutil.Decode(signature)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid signature: %w", err)
+	}
+
+	if len(sig) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("invalid signature length %d", len(sig))
+	}
+
+	// wallets return V as 27/28
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pub, err := crypto.SigToPub(textHash(text), sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to recover signer: %w", err)
+	}
+
+	return crypto.PubkeyToAddress(*pub), nil
+}
@@ -0,0 +1,202 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// the first account of the Hardhat and Anvil test nodes
+	TEST_KEY     = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+	TEST_ADDRESS = "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+
+	TEST_DOMAIN   = "savva.app"
+	TEST_URI      = "https://savva.app"
+	TEST_CHAIN_ID = 4158
+)
+
+var testTime = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func testKey(t *testing.T, hex string) *ecdsa.PrivateKey {
+	t.Helper()
+
+	key, err := crypto.HexToECDSA(hex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// siweMessage returns the text of the message, the fields replace the lines with the same key
+func siweMessage(address string, fields ...string) string {
+	lines := []string{
+		TEST_DOMAIN + SIWE_HEADER_SUFFIX,
+		address,
+		"",
+		"Sign in to SAVVA reports",
+		"",
+		"URI: " + TEST_URI,
+		"Version: 1",
+		"Chain ID: 4158",
+		"Nonce: abc123",
+		"Issued At: " + testTime.Format(time.RFC3339),
+	}
+
+	for _, f := range fields {
+		key, _, _ := strings.Cut(f, ": ")
+		replaced := false
+		for i, line := range lines {
+			if strings.HasPrefix(line, key+": ") {
+				lines[i], replaced = f, true
+			}
+		}
+		if !replaced {
+			lines = append(lines, f)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// sign returns the personal_sign signature of the text with V as 27/28, as the wallets do
+func sign(t *testing.T, key *ecdsa.PrivateKey, text string) string {
+	t.Helper()
+
+	sig, err := crypto.Sign(textHash(text), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return hexutil.Encode(sig)
+}
+
+func TestParseSiweMessage(t *testing.T) {
+	m, err := ParseSiweMessage(siweMessage(TEST_ADDRESS, "Expiration Time: 2025-03-01T13:00:00Z", "Not Before: 2025-03-01T11:00:00Z"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Domain != TEST_DOMAIN || m.Address != common.HexToAddress(TEST_ADDRESS) || m.Statement != "Sign in to SAVVA reports" ||
+		m.URI != TEST_URI || m.Version != "1" || m.ChainID != "4158" || m.Nonce != "abc123" || !m.IssuedAt.Equal(testTime) {
+		t.Errorf("ParseSiweMessage() = %+v", m)
+	}
+	if m.ExpirationTime == nil || !m.ExpirationTime.Equal(testTime.Add(time.Hour)) {
+		t.Errorf("Expiration Time = %v", m.ExpirationTime)
+	}
+	if m.NotBefore == nil || !m.NotBefore.Equal(testTime.Add(-time.Hour)) {
+		t.Errorf("Not Before = %v", m.NotBefore)
+	}
+}
+
+func TestParseSiweMessageErrors(t *testing.T) {
+	valid := siweMessage(TEST_ADDRESS)
+
+	tests := []struct {
+		name    string
+		message string
+		want    string
+	}{
+		{"empty", "", "too short"},
+		{"no header", strings.Replace(valid, SIWE_HEADER_SUFFIX, " wants you to sign in:", 1), "invalid header"},
+		{"no domain", strings.TrimPrefix(valid, TEST_DOMAIN), "invalid header"},
+		{"invalid address", siweMessage("0x1234"), "invalid address"},
+		{"no nonce", siweMessage(TEST_ADDRESS, "Nonce: "), "required"},
+		{"no uri", strings.Replace(valid, "URI: "+TEST_URI+"\n", "", 1), "required"},
+		{"no chain id", strings.Replace(valid, "Chain ID: 4158\n", "", 1), "required"},
+		{"version", siweMessage(TEST_ADDRESS, "Version: 2"), "unsupported version"},
+		{"issued at", siweMessage(TEST_ADDRESS, "Issued At: yesterday"), "invalid Issued At"},
+		{"expiration time", siweMessage(TEST_ADDRESS, "Expiration Time: 2025-03-01"), "invalid Expiration Time"},
+		{"not before", siweMessage(TEST_ADDRESS, "Not Before: soon"), "invalid Not Before"},
+		{"invalid line", siweMessage(TEST_ADDRESS, "garbage"), "invalid line"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSiweMessage(tt.message)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("ParseSiweMessage() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckTime(t *testing.T) {
+	const maxAge = 10 * time.Minute
+
+	tests := []struct {
+		name   string
+		fields []string
+		now    time.Time
+		want   string // part of the error, empty if valid
+	}{
+		{"just issued", nil, testTime, ""},
+		{"within max age", nil, testTime.Add(maxAge), ""},
+		{"too old", nil, testTime.Add(maxAge + time.Second), "too old"},
+		{"clock skew", nil, testTime.Add(-time.Minute), ""},
+		{"issued in the future", nil, testTime.Add(-time.Minute - time.Second), "in the future"},
+		{"before expiration", []string{"Expiration Time: 2025-03-01T12:05:00Z"}, testTime.Add(5 * time.Minute), ""},
+		{"expired", []string{"Expiration Time: 2025-03-01T12:05:00Z"}, testTime.Add(5*time.Minute + time.Second), "expired"},
+		{"not valid yet", []string{"Not Before: 2025-03-01T12:05:00Z"}, testTime.Add(time.Minute), "not valid yet"},
+		{"after not before", []string{"Not Before: 2025-03-01T12:05:00Z"}, testTime.Add(5 * time.Minute), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := ParseSiweMessage(siweMessage(TEST_ADDRESS, tt.fields...))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = m.CheckTime(tt.now, maxAge)
+			if tt.want == "" && err != nil || tt.want != "" && (err == nil || !strings.Contains(err.Error(), tt.want)) {
+				t.Errorf("CheckTime() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecoverSigner(t *testing.T) {
+	key := testKey(t, TEST_KEY)
+	message := siweMessage(TEST_ADDRESS)
+	signature := sign(t, key, message)
+
+	// V as 0/1 as some signers return it
+	raw, _ := hexutil.Decode(signature)
+	raw[crypto.RecoveryIDOffset] -= 27
+	signature01 := hexutil.Encode(raw)
+
+	tests := []struct {
+		name      string
+		message   string
+		signature string
+		want      string // the address, empty if the recovery fails
+	}{
+		{"known key", message, signature, TEST_ADDRESS},
+		{"v 0/1", message, signature01, TEST_ADDRESS},
+		{"other message", message + " ", signature, ""},
+		{"not hex", message, "0xzz", ""},
+		{"no prefix", message, strings.TrimPrefix(signature, "0x"), ""},
+		{"short", message, signature[:len(signature)-2], ""},
+		{"long", message, signature + "00", ""},
+		{"invalid v", message, signature[:len(signature)-2] + "05", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RecoverSigner(tt.message, tt.signature)
+			switch {
+			case tt.want != "" && err != nil:
+				t.Errorf("RecoverSigner() error = %v", err)
+			case tt.want != "" && got != common.HexToAddress(tt.want):
+				t.Errorf("RecoverSigner() = %s, want %s", got.Hex(), tt.want)
+			case tt.want == "" && err == nil && got == common.HexToAddress(TEST_ADDRESS):
+				t.Errorf("RecoverSigner() = %s, want an error or another address", got.Hex())
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package text
+
+import "unicode"
+
+// joining types of ArabicShaping.txt
+const (
+	JOIN_NONE        = iota // U, the letters that do not join
+	JOIN_RIGHT              // R, joins only the previous letter
+	JOIN_DUAL               // D, joins both sides
+	JOIN_CAUSING            // C, tatweel and ZWJ
+	JOIN_TRANSPARENT        // T, the combining marks
+)
+
+// arabicForm are the presentation forms of a letter, 0 if the letter has none
+type arabicForm struct {
+	joining                          int
+	isolated, final, initial, medial rune
+}
+
+// ARABIC_FORMS are the presentation forms of the Arabic letters
+var ARABIC_FORMS = map[rune]arabicForm{
+	'ء': {JOIN_NONE, 0xFE80, 0, 0, 0},
+	'آ': {JOIN_RIGHT, 0xFE81, 0xFE82, 0, 0},
+	'أ': {JOIN_RIGHT, 0xFE83, 0xFE84, 0, 0},
+	'ؤ': {JOIN_RIGHT, 0xFE85, 0xFE86, 0, 0},
+	'إ': {JOIN_RIGHT, 0xFE87, 0xFE88, 0, 0},
+	'ئ': {JOIN_DUAL, 0xFE89, 0xFE8A, 0xFE8B, 0xFE8C},
+	'ا': {JOIN_RIGHT, 0xFE8D, 0xFE8E, 0, 0},
+	'ب': {JOIN_DUAL, 0xFE8F, 0xFE90, 0xFE91, 0xFE92},
+	'ة': {JOIN_RIGHT, 0xFE93, 0xFE94, 0, 0},
+	'ت': {JOIN_DUAL, 0xFE95, 0xFE96, 0xFE97, 0xFE98},
+	'ث': {JOIN_DUAL, 0xFE99, 0xFE9A, 0xFE9B, 0xFE9C},
+	'ج': {JOIN_DUAL, 0xFE9D, 0xFE9E, 0xFE9F, 0xFEA0},
+	'ح': {JOIN_DUAL, 0xFEA1, 0xFEA2, 0xFEA3, 0xFEA4},
+	'خ': {JOIN_DUAL, 0xFEA5, 0xFEA6, 0xFEA7, 0xFEA8},
+	'د': {JOIN_RIGHT, 0xFEA9, 0xFEAA, 0, 0},
+	'ذ': {JOIN_RIGHT, 0xFEAB, 0xFEAC, 0, 0},
+	'ر': {JOIN_RIGHT, 0xFEAD, 0xFEAE, 0, 0},
+	'ز': {JOIN_RIGHT, 0xFEAF, 0xFEB0, 0, 0},
+	'س': {JOIN_DUAL, 0xFEB1, 0xFEB2, 0xFEB3, 0xFEB4},
+	'ش': {JOIN_DUAL, 0xFEB5, 0xFEB6, 0xFEB7, 0xFEB8},
+	'ص': {JOIN_DUAL, 0xFEB9, 0xFEBA, 0xFEBB, 0xFEBC},
+	'ض': {JOIN_DUAL, 0xFEBD, 0xFEBE, 0xFEBF, 0xFEC0},
+	'ط': {JOIN_DUAL, 0xFEC1, 0xFEC2, 0xFEC3, 0xFEC4},
+	'ظ': {JOIN_DUAL, 0xFEC5, 0xFEC6, 0xFEC7, 0xFEC8},
+	'ع': {JOIN_DUAL, 0xFEC9, 0xFECA, 0xFECB, 0xFECC},
+	'غ': {JOIN_DUAL, 0xFECD, 0xFECE, 0xFECF, 0xFED0},
+	'ف': {JOIN_DUAL, 0xFED1, 0xFED2, 0xFED3, 0xFED4},
+	'ق': {JOIN_DUAL, 0xFED5, 0xFED6, 0xFED7, 0xFED8},
+	'ك': {JOIN_DUAL, 0xFED9, 0xFEDA, 0xFEDB, 0xFEDC},
+	'ل': {JOIN_DUAL, 0xFEDD, 0xFEDE, 0xFEDF, 0xFEE0},
+	'م': {JOIN_DUAL, 0xFEE1, 0xFEE2, 0xFEE3, 0xFEE4},
+	'ن': {JOIN_DUAL, 0xFEE5, 0xFEE6, 0xFEE7, 0xFEE8},
+	'ه': {JOIN_DUAL, 0xFEE9, 0xFEEA, 0xFEEB, 0xFEEC},
+	'و': {JOIN_RIGHT, 0xFEED, 0xFEEE, 0, 0},
+	'ى': {JOIN_RIGHT, 0xFEEF, 0xFEF0, 0, 0},
+	'ي': {JOIN_DUAL, 0xFEF1, 0xFEF2, 0xFEF3, 0xFEF4},
+
+	// Persian and Urdu
+	'ٱ': {JOIN_RIGHT, 0xFB50, 0xFB51, 0, 0},
+	'ٹ': {JOIN_DUAL, 0xFB66, 0xFB67, 0xFB68, 0xFB69},
+	'پ': {JOIN_DUAL, 0xFB56, 0xFB57, 0xFB58, 0xFB59},
+	'چ': {JOIN_DUAL, 0xFB7A, 0xFB7B, 0xFB7C, 0xFB7D},
+	'ڈ': {JOIN_RIGHT, 0xFB88, 0xFB89, 0, 0},
+	'ڑ': {JOIN_RIGHT, 0xFB8C, 0xFB8D, 0, 0},
+	'ژ': {JOIN_RIGHT, 0xFB8A, 0xFB8B, 0, 0},
+	'ک': {JOIN_DUAL, 0xFB8E, 0xFB8F, 0xFB90, 0xFB91},
+	'گ': {JOIN_DUAL, 0xFB92, 0xFB93, 0xFB94, 0xFB95},
+	'ھ': {JOIN_DUAL, 0xFBAA, 0xFBAB, 0xFBAC, 0xFBAD},
+	'ہ': {JOIN_DUAL, 0xFBA6, 0xFBA7, 0xFBA8, 0xFBA9},
+	'ی': {JOIN_DUAL, 0xFBFC, 0xFBFD, 0xFBFE, 0xFBFF},
+	'ے': {JOIN_RIGHT, 0xFBAE, 0xFBAF, 0, 0},
+}
+
+// LAM_ALEF are the ligatures of lam with the alefs: isolated and final
+var LAM_ALEF = map[rune][2]rune{
+	'آ': {0xFEF5, 0xFEF6},
+	'أ': {0xFEF7, 0xFEF8},
+	'إ': {0xFEF9, 0xFEFA},
+	'ا': {0xFEFB, 0xFEFC},
+}
+
+// joiningOf returns the joining type of the character
+func joiningOf(r rune) int {
+	if f, ok := ARABIC_FORMS[r]; ok {
+		return f.joining
+	}
+	switch {
+	case r == '\u0640' || r == '\u200d': // tatweel, ZWJ
+		return JOIN_CAUSING
+	case unicode.Is(unicode.Mn, r):
+		return JOIN_TRANSPARENT
+	}
+	return JOIN_NONE
+}
+
+// Shape replaces the Arabic letters with their contextual presentation forms and
+// the lam-alef ligatures, as the fonts have no shaping tables that gopdf applies.
+// The text is in the logical order.
+func Shape(s string) string {
+	if !hasArabic(s) {
+		return s
+	}
+
+	runes := []rune(s)
+	joining := make([]int, len(runes))
+	for i, r := range runes {
+		joining[i] = joiningOf(r)
+	}
+
+	// the neighbours that are not the combining marks
+	prev := func(i int) int {
+		for i--; i >= 0 && joining[i] == JOIN_TRANSPARENT; i-- {
+		}
+		return i
+	}
+	next := func(i int) int {
+		for i++; i < len(runes) && joining[i] == JOIN_TRANSPARENT; i++ {
+		}
+		return i
+	}
+	joinsNext := func(i int) bool { // i joins the following letter
+		return i >= 0 && (joining[i] == JOIN_DUAL || joining[i] == JOIN_CAUSING)
+	}
+	joinsPrev := func(i int) bool { // i joins the preceding letter
+		return i < len(runes) && (joining[i] == JOIN_DUAL || joining[i] == JOIN_RIGHT || joining[i] == JOIN_CAUSING)
+	}
+
+	out := make([]rune, 0, len(runes))
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		f, ok := ARABIC_FORMS[r]
+		if !ok {
+			if r != '\u200d' && r != '\u200c' { // the joiners are not drawn
+				out = append(out, r)
+			}
+			continue
+		}
+
+		p, n := prev(i), next(i)
+		before := joinsNext(p)
+		after := f.joining == JOIN_DUAL && joinsPrev(n)
+
+		if r == 'ل' && i+1 < len(runes) {
+			if lig, ok := LAM_ALEF[runes[i+1]]; ok {
+				if before {
+					out = append(out, lig[1])
+				} else {
+					out = append(out, lig[0])
+				}
+				i++
+				continue
+			}
+		}
+
+		form := f.isolated
+		switch {
+		case before && after && f.medial != 0:
+			form = f.medial
+		case before && f.final != 0:
+			form = f.final
+		case after && f.initial != 0:
+			form = f.initial
+		}
+		out = append(out, form)
+	}
+	return string(out)
+}
+
+// hasArabic reports whether the text has the letters to shape
+func hasArabic(s string) bool {
+	for _, r := range s {
+		if r >= 0x0600 && r <= 0x06FF {
+			return true
+		}
+	}
+	return false
+}
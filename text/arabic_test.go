@@ -0,0 +1,51 @@
+package text
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestShape(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"latin", "abc", "abc"},
+		{"isolated", "ب", "ﺏ"},
+		{"initial and final", "بب", "ﺑﺐ"},
+		{"medial", "ببب", "ﺑﺒﺐ"},
+		{"right joining final", "با", "ﺑﺎ"},
+		{"right joining does not join the next", "اب", "ﺍﺏ"},
+		{"non joining", "بءب", "ﺏﺀﺏ"},
+		{"word", "محمد", "ﻣﺤﻤﺪ"},
+		{"words", "بب بب", "ﺑﺐ ﺑﺐ"},
+		{"lam alef", "لا", "ﻻ"},
+		{"lam alef final", "بلا", "ﺑﻼ"},
+		{"lam alef hamza", "لأ", "ﻷ"},
+		{"lam alef madda final", "بلآ", "ﺑﻶ"},
+		{"lam alef in a word", "سلام", "ﺳﻼﻡ"},
+		{"transparent mark", "بَب", "ﺑَﺐ"},
+		{"tatweel", "بـب", "ﺑـﺐ"},
+		{"zwj", "ب‍", "ﺑ"},
+		{"zwnj", "ب‌ب", "ﺏﺏ"},
+		{"persian", "پی", "ﭘﯽ"},
+		{"digits", "ب12", "ﺏ12"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Shape(tt.s); got != tt.want {
+				t.Errorf("Shape(%q) = %s, want %s", tt.s, codePoints(got), codePoints(tt.want))
+			}
+		})
+	}
+}
+
+func codePoints(s string) string {
+	r := ""
+	for _, c := range s {
+		r += fmt.Sprintf("U+%04X ", c)
+	}
+	return r
+}
@@ -0,0 +1,720 @@
+// Package text prepares the Unicode text for drawing: the bidirectional reordering
+// and the shaping of the Arabic letters.
+package text
+
+import (
+	"slices"
+
+	"golang.org/x/text/unicode/bidi"
+)
+
+// Direction is the direction of a paragraph
+type Direction int
+
+const (
+	DIRECTION_LTR  Direction = iota
+	DIRECTION_RTL            // Arabic, Hebrew...
+	DIRECTION_AUTO           // of the first strong character, LTR if there is none
+)
+
+// MAX_DEPTH is the maximum explicit embedding level of UAX #9
+const MAX_DEPTH = 125
+
+// MAX_BRACKET_PAIRS limits the nesting of the brackets paired by the rule N0
+const MAX_BRACKET_PAIRS = 63
+
+// Paragraph is a paragraph of text with the embedding levels resolved by the
+// Unicode Bidirectional Algorithm (UAX #9). The lines of the paragraph are
+// reordered for drawing with Visual.
+type Paragraph struct {
+	runes  []rune
+	types  []bidi.Class // original bidi classes
+	levels []uint8
+	level  uint8 // paragraph embedding level
+
+	matchingPDI       []int // of the isolate initiators, -1 if none
+	matchingInitiator []int // of the PDIs, -1 if none
+}
+
+// NewParagraph resolves the embedding levels of the text
+func NewParagraph(s string, dir Direction) *Paragraph {
+	p := &Paragraph{runes: []rune(s)}
+	n := len(p.runes)
+
+	p.types = make([]bidi.Class, n)
+	for i, r := range p.runes {
+		p.types[i] = classOf(r)
+	}
+	p.matchIsolates()
+
+	switch dir {
+	case DIRECTION_RTL:
+		p.level = 1
+	case DIRECTION_AUTO:
+		if d, ok := p.firstStrong(0, n); ok && d == DIRECTION_RTL {
+			p.level = 1
+		}
+	}
+
+	resolved := p.explicitLevels()
+	for _, seq := range p.isolatingRunSequences(resolved) {
+		p.resolveSequence(seq, resolved)
+	}
+	p.assignRemovedLevels()
+	return p
+}
+
+// Direction returns the paragraph direction
+func (p *Paragraph) Direction() Direction {
+	if p.level&1 == 1 {
+		return DIRECTION_RTL
+	}
+	return DIRECTION_LTR
+}
+
+// Len returns the number of characters of the paragraph
+func (p *Paragraph) Len() int {
+	return len(p.runes)
+}
+
+// Visual returns the characters start:end of the paragraph, one line, in the
+// order they are drawn from left to right. The mirrored characters are replaced
+// in the right-to-left runs and the formatting characters are removed.
+func (p *Paragraph) Visual(start, end int) string {
+	levels := slices.Clone(p.levels[start:end])
+
+	// L1: the separators and the trailing whitespace get the paragraph level
+	trailing := true
+	for i := len(levels) - 1; i >= 0; i-- {
+		switch t := p.types[start+i]; {
+		case t == bidi.S || t == bidi.B:
+			levels[i] = p.level
+			trailing = true
+		case t == bidi.WS || isIsolateControl(t) || isRemoved(t):
+			if trailing {
+				levels[i] = p.level
+			}
+		default:
+			trailing = false
+		}
+	}
+
+	// L2: reverse the runs from the highest level to the lowest odd one
+	order := make([]int, len(levels))
+	for i := range order {
+		order[i] = start + i
+	}
+
+	highest, lowestOdd := uint8(0), uint8(MAX_DEPTH+2)
+	for _, l := range levels {
+		highest = max(highest, l)
+		if l&1 == 1 {
+			lowestOdd = min(lowestOdd, l)
+		}
+	}
+
+	for level := highest; level >= lowestOdd && level > 0; level-- {
+		for i := 0; i < len(order); {
+			if levels[order[i]-start] < level {
+				i++
+				continue
+			}
+			j := i + 1
+			for j < len(order) && levels[order[j]-start] >= level {
+				j++
+			}
+			slices.Reverse(order[i:j])
+			i = j
+		}
+	}
+
+	// L3: the combining marks stay after their base characters
+	for i := 0; i < len(order); i++ {
+		if p.types[order[i]] != bidi.NSM || levels[order[i]-start]&1 == 0 {
+			continue
+		}
+		j := i
+		for j < len(order) && p.types[order[j]] == bidi.NSM {
+			j++
+		}
+		if j < len(order) {
+			base := order[j]
+			copy(order[i+1:j+1], order[i:j])
+			order[i] = base
+		}
+		i = j
+	}
+
+	// L4: mirror the characters of the right-to-left runs
+	out := make([]rune, 0, len(order))
+	for _, i := range order {
+		t := p.types[i]
+		if isRemoved(t) || isIsolateControl(t) || isMark(p.runes[i]) {
+			continue
+		}
+		r := p.runes[i]
+		if levels[i-start]&1 == 1 {
+			if m, ok := MIRRORS[r]; ok {
+				r = m
+			}
+		}
+		out = append(out, r)
+	}
+	return string(out)
+}
+
+// Visual returns the line of text in the order it is drawn from left to right
+func Visual(s string, dir Direction) string {
+	if dir != DIRECTION_RTL && !NeedsBidi(s) {
+		return s
+	}
+	p := NewParagraph(s, dir)
+	return p.Visual(0, p.Len())
+}
+
+// NeedsBidi reports whether the text may be drawn differently in a left-to-right
+// paragraph than in its logical order, i.e. has the right-to-left or the
+// formatting characters
+func NeedsBidi(s string) bool {
+	for _, r := range s {
+		if r >= 0x0590 {
+			switch classOf(r) {
+			case bidi.R, bidi.AL, bidi.AN, bidi.RLE, bidi.RLO, bidi.RLI, bidi.FSI,
+				bidi.LRE, bidi.LRO, bidi.LRI, bidi.PDF, bidi.PDI, bidi.BN:
+				return true
+			}
+			if isMark(r) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// DetectDirection returns the direction of the first strong character,
+// ok is false if there is none
+func DetectDirection(s string) (dir Direction, ok bool) {
+	p := &Paragraph{runes: []rune(s)}
+	p.types = make([]bidi.Class, len(p.runes))
+	for i, r := range p.runes {
+		p.types[i] = classOf(r)
+	}
+	p.matchIsolates()
+	return p.firstStrong(0, len(p.runes))
+}
+
+// classOf returns the bidi class of the character, the unassigned characters are L
+func classOf(r rune) bidi.Class {
+	props, _ := bidi.LookupRune(r)
+	return props.Class()
+}
+
+func isIsolateInitiator(t bidi.Class) bool {
+	return t == bidi.LRI || t == bidi.RLI || t == bidi.FSI
+}
+
+func isIsolateControl(t bidi.Class) bool {
+	return isIsolateInitiator(t) || t == bidi.PDI
+}
+
+// isRemoved reports whether the rule X9 removes the characters of the class
+func isRemoved(t bidi.Class) bool {
+	switch t {
+	case bidi.RLE, bidi.LRE, bidi.RLO, bidi.LRO, bidi.PDF, bidi.BN:
+		return true
+	}
+	return false
+}
+
+// isMark reports whether the character is an invisible direction mark
+func isMark(r rune) bool {
+	return r == '\u200e' || r == '\u200f' || r == '\u061c' // LRM, RLM, ALM
+}
+
+// matchIsolates finds the matching PDIs of the isolate initiators (BD9)
+func (p *Paragraph) matchIsolates() {
+	n := len(p.types)
+	p.matchingPDI = make([]int, n)
+	p.matchingInitiator = make([]int, n)
+	for i := range p.types {
+		p.matchingPDI[i], p.matchingInitiator[i] = -1, -1
+	}
+
+	var open []int
+	for i, t := range p.types {
+		switch {
+		case isIsolateInitiator(t):
+			open = append(open, i)
+		case t == bidi.PDI && len(open) > 0:
+			j := open[len(open)-1]
+			open = open[:len(open)-1]
+			p.matchingPDI[j], p.matchingInitiator[i] = i, j
+		case t == bidi.B:
+			open = open[:0]
+		}
+	}
+}
+
+// firstStrong returns the direction of the first strong character of start:end,
+// the isolates are skipped (P2, P3)
+func (p *Paragraph) firstStrong(start, end int) (Direction, bool) {
+	for i := start; i < end; i++ {
+		switch t := p.types[i]; {
+		case t == bidi.L:
+			return DIRECTION_LTR, true
+		case t == bidi.R || t == bidi.AL:
+			return DIRECTION_RTL, true
+		case isIsolateInitiator(t):
+			if p.matchingPDI[i] < 0 {
+				return DIRECTION_LTR, false
+			}
+			i = p.matchingPDI[i]
+		}
+	}
+	return DIRECTION_LTR, false
+}
+
+// explicitLevels applies the rules X1-X8 and returns the classes with the overrides
+func (p *Paragraph) explicitLevels() []bidi.Class {
+	type status struct {
+		level    uint8
+		override bidi.Class // L, R or ON if none
+		isolate  bool
+	}
+
+	resolved := slices.Clone(p.types)
+	p.levels = make([]uint8, len(p.types))
+
+	stack := []status{{p.level, bidi.ON, false}}
+	overflowIsolates, overflowEmbeddings, validIsolates := 0, 0, 0
+
+	next := func(rtl bool) uint8 {
+		l := stack[len(stack)-1].level
+		if rtl {
+			return (l + 1) | 1
+		}
+		return (l + 2) &^ 1
+	}
+
+	for i, t := range p.types {
+		top := stack[len(stack)-1]
+
+		switch t {
+		case bidi.RLE, bidi.LRE, bidi.RLO, bidi.LRO: // X2-X5
+			p.levels[i] = top.level
+			level := next(t == bidi.RLE || t == bidi.RLO)
+			if level <= MAX_DEPTH && overflowIsolates == 0 && overflowEmbeddings == 0 {
+				override := bidi.ON
+				switch t {
+				case bidi.RLO:
+					override = bidi.R
+				case bidi.LRO:
+					override = bidi.L
+				}
+				stack = append(stack, status{level, override, false})
+			} else if overflowIsolates == 0 {
+				overflowEmbeddings++
+			}
+
+		case bidi.RLI, bidi.LRI, bidi.FSI: // X5a-X5c
+			p.levels[i] = top.level
+			if top.override != bidi.ON {
+				resolved[i] = top.override
+			}
+
+			rtl := t == bidi.RLI
+			if t == bidi.FSI {
+				end := p.matchingPDI[i]
+				if end < 0 {
+					end = len(p.types)
+				}
+				d, ok := p.firstStrong(i+1, end)
+				rtl = ok && d == DIRECTION_RTL
+			}
+
+			level := next(rtl)
+			if level <= MAX_DEPTH && overflowIsolates == 0 && overflowEmbeddings == 0 {
+				validIsolates++
+				stack = append(stack, status{level, bidi.ON, true})
+			} else {
+				overflowIsolates++
+			}
+
+		case bidi.PDI: // X6a
+			if overflowIsolates > 0 {
+				overflowIsolates--
+			} else if validIsolates > 0 {
+				overflowEmbeddings = 0
+				for !stack[len(stack)-1].isolate {
+					stack = stack[:len(stack)-1]
+				}
+				stack = stack[:len(stack)-1]
+				validIsolates--
+			}
+			top = stack[len(stack)-1]
+			p.levels[i] = top.level
+			if top.override != bidi.ON {
+				resolved[i] = top.override
+			}
+
+		case bidi.PDF: // X7
+			p.levels[i] = top.level
+			if overflowIsolates > 0 {
+			} else if overflowEmbeddings > 0 {
+				overflowEmbeddings--
+			} else if !top.isolate && len(stack) >= 2 {
+				stack = stack[:len(stack)-1]
+			}
+
+		case bidi.B: // X8
+			p.levels[i] = p.level
+
+		case bidi.BN:
+			p.levels[i] = top.level
+
+		default: // X6
+			p.levels[i] = top.level
+			if top.override != bidi.ON {
+				resolved[i] = top.override
+			}
+		}
+	}
+	return resolved
+}
+
+// isolatingRunSequences returns the isolating run sequences of the characters
+// not removed by X9 (BD13, X10)
+func (p *Paragraph) isolatingRunSequences(resolved []bidi.Class) [][]int {
+	var runs [][]int
+	runOf := make([]int, len(p.types))
+	var current []int
+	for i, t := range p.types {
+		if isRemoved(t) {
+			continue
+		}
+		if len(current) > 0 && p.levels[current[len(current)-1]] != p.levels[i] {
+			runs = append(runs, current)
+			current = nil
+		}
+		current = append(current, i)
+		runOf[i] = len(runs)
+	}
+	if len(current) > 0 {
+		runs = append(runs, current)
+	}
+
+	var sequences [][]int
+	for _, run := range runs {
+		first := run[0]
+		if p.types[first] == bidi.PDI && p.matchingInitiator[first] >= 0 {
+			continue // continues the sequence of its initiator
+		}
+
+		seq := slices.Clone(run)
+		for {
+			last := seq[len(seq)-1]
+			if !isIsolateInitiator(p.types[last]) || p.matchingPDI[last] < 0 {
+				break
+			}
+			seq = append(seq, runs[runOf[p.matchingPDI[last]]]...)
+		}
+		sequences = append(sequences, seq)
+	}
+	return sequences
+}
+
+// strongOf returns the direction of the level
+func strongOf(level uint8) bidi.Class {
+	if level&1 == 1 {
+		return bidi.R
+	}
+	return bidi.L
+}
+
+// resolveSequence applies the rules W1-W7, N0-N2 and I1-I2 to the isolating run sequence
+func (p *Paragraph) resolveSequence(seq []int, resolved []bidi.Class) {
+	level := p.levels[seq[0]]
+
+	// the start and the end of sequence types (X10)
+	prev := p.level
+	for i := seq[0] - 1; i >= 0; i-- {
+		if !isRemoved(p.types[i]) {
+			prev = p.levels[i]
+			break
+		}
+	}
+	sos := strongOf(max(level, prev))
+
+	next := p.level
+	if last := seq[len(seq)-1]; !isIsolateInitiator(p.types[last]) {
+		for i := last + 1; i < len(p.types); i++ {
+			if !isRemoved(p.types[i]) {
+				next = p.levels[i]
+				break
+			}
+		}
+	}
+	eos := strongOf(max(level, next))
+
+	ts := make([]bidi.Class, len(seq))
+	for k, i := range seq {
+		ts[k] = resolved[i]
+	}
+
+	// W1: the non-spacing marks get the type of the previous character
+	for k, t := range ts {
+		if t != bidi.NSM {
+			continue
+		}
+		switch {
+		case k == 0:
+			ts[k] = sos
+		case isIsolateControl(ts[k-1]):
+			ts[k] = bidi.ON
+		default:
+			ts[k] = ts[k-1]
+		}
+	}
+
+	// W2: the European numbers after the Arabic letters are Arabic numbers
+	for k, t := range ts {
+		if t != bidi.EN {
+			continue
+		}
+		for j := k - 1; j >= 0; j-- {
+			if ts[j] == bidi.L || ts[j] == bidi.R || ts[j] == bidi.AL {
+				if ts[j] == bidi.AL {
+					ts[k] = bidi.AN
+				}
+				break
+			}
+		}
+	}
+
+	// W3
+	for k, t := range ts {
+		if t == bidi.AL {
+			ts[k] = bidi.R
+		}
+	}
+
+	// W4: a single separator between two numbers of the same type
+	for k := 1; k < len(ts)-1; k++ {
+		switch {
+		case ts[k] == bidi.ES && ts[k-1] == bidi.EN && ts[k+1] == bidi.EN:
+			ts[k] = bidi.EN
+		case ts[k] == bidi.CS && ts[k-1] == ts[k+1] && (ts[k-1] == bidi.EN || ts[k-1] == bidi.AN):
+			ts[k] = ts[k-1]
+		}
+	}
+
+	// W5: the terminators adjacent to the European numbers
+	for k := 0; k < len(ts); k++ {
+		if ts[k] != bidi.ET {
+			continue
+		}
+		e := k
+		for e < len(ts) && ts[e] == bidi.ET {
+			e++
+		}
+		if k > 0 && ts[k-1] == bidi.EN || e < len(ts) && ts[e] == bidi.EN {
+			for j := k; j < e; j++ {
+				ts[j] = bidi.EN
+			}
+		}
+		k = e - 1
+	}
+
+	// W6
+	for k, t := range ts {
+		if t == bidi.ES || t == bidi.ET || t == bidi.CS {
+			ts[k] = bidi.ON
+		}
+	}
+
+	// W7: the European numbers in the left-to-right context
+	for k, t := range ts {
+		if t != bidi.EN {
+			continue
+		}
+		context := sos
+		for j := k - 1; j >= 0; j-- {
+			if ts[j] == bidi.L || ts[j] == bidi.R {
+				context = ts[j]
+				break
+			}
+		}
+		if context == bidi.L {
+			ts[k] = bidi.L
+		}
+	}
+
+	p.resolveBrackets(seq, ts, sos, level)
+
+	// N1, N2: the neutrals between the characters of the same direction take it,
+	// the others take the embedding direction
+	for k := 0; k < len(ts); k++ {
+		if !isNeutral(ts[k]) {
+			continue
+		}
+		e := k
+		for e < len(ts) && isNeutral(ts[e]) {
+			e++
+		}
+
+		before, after := sos, eos
+		if k > 0 {
+			before = strongN(ts[k-1])
+		}
+		if e < len(ts) {
+			after = strongN(ts[e])
+		}
+
+		dir := strongOf(level)
+		if before == after {
+			dir = before
+		}
+		for j := k; j < e; j++ {
+			ts[j] = dir
+		}
+		k = e - 1
+	}
+
+	// I1, I2
+	for k, i := range seq {
+		switch t := ts[k]; {
+		case level&1 == 0 && t == bidi.R:
+			p.levels[i]++
+		case level&1 == 0 && (t == bidi.AN || t == bidi.EN):
+			p.levels[i] += 2
+		case level&1 == 1 && (t == bidi.L || t == bidi.AN || t == bidi.EN):
+			p.levels[i]++
+		}
+	}
+}
+
+// isNeutral reports whether the class is resolved by N1 and N2
+func isNeutral(t bidi.Class) bool {
+	switch t {
+	case bidi.B, bidi.S, bidi.WS, bidi.ON, bidi.LRI, bidi.RLI, bidi.FSI, bidi.PDI:
+		return true
+	}
+	return false
+}
+
+// strongN returns the direction of the class for N0-N2, the numbers are R,
+// ON if the class is not strong
+func strongN(t bidi.Class) bidi.Class {
+	switch t {
+	case bidi.L:
+		return bidi.L
+	case bidi.R, bidi.AL, bidi.EN, bidi.AN:
+		return bidi.R
+	}
+	return bidi.ON
+}
+
+// resolveBrackets applies the rule N0: the paired brackets take the direction of their content
+func (p *Paragraph) resolveBrackets(seq []int, ts []bidi.Class, sos bidi.Class, level uint8) {
+	type pair struct{ open, close int }
+	type opening struct {
+		k     int
+		close rune
+	}
+
+	var pairs []pair
+	var stack []opening
+brackets:
+	for k, i := range seq {
+		if ts[k] != bidi.ON {
+			continue
+		}
+		r := canonicalBracket(p.runes[i])
+		props, _ := bidi.LookupRune(r)
+		if !props.IsBracket() {
+			continue
+		}
+
+		if props.IsOpeningBracket() {
+			if len(stack) == MAX_BRACKET_PAIRS {
+				break brackets
+			}
+			stack = append(stack, opening{k, canonicalBracket(MIRRORS[r])})
+			continue
+		}
+
+		for j := len(stack) - 1; j >= 0; j-- {
+			if stack[j].close == r {
+				pairs = append(pairs, pair{stack[j].k, k})
+				stack = stack[:j]
+				break
+			}
+		}
+	}
+	slices.SortFunc(pairs, func(a, b pair) int { return a.open - b.open })
+
+	embedding := strongOf(level)
+	for _, pr := range pairs {
+		found := bidi.ON
+		for k := pr.open + 1; k < pr.close; k++ {
+			d := strongN(ts[k])
+			if d == embedding {
+				found = embedding
+				break
+			}
+			if d != bidi.ON {
+				found = d
+			}
+		}
+
+		if found == bidi.ON {
+			continue
+		}
+
+		if found != embedding {
+			context := sos
+			for k := pr.open - 1; k >= 0; k-- {
+				if d := strongN(ts[k]); d != bidi.ON {
+					context = d
+					break
+				}
+			}
+			if context != found {
+				found = embedding
+			}
+		}
+
+		for _, k := range []int{pr.open, pr.close} {
+			ts[k] = found
+			for j := k + 1; j < len(ts) && p.types[seq[j]] == bidi.NSM; j++ {
+				ts[j] = found
+			}
+		}
+	}
+}
+
+// canonicalBracket returns the canonical equivalent of the angle brackets
+func canonicalBracket(r rune) rune {
+	switch r {
+	case '\u2329':
+		return '\u3008'
+	case '\u232a':
+		return '\u3009'
+	}
+	return r
+}
+
+// assignRemovedLevels gives the characters removed by X9 the level of the previous
+// character, so they do not break the runs of the line
+func (p *Paragraph) assignRemovedLevels() {
+	for i, t := range p.types {
+		if !isRemoved(t) {
+			continue
+		}
+		if i == 0 {
+			p.levels[i] = p.level
+		} else {
+			p.levels[i] = p.levels[i-1]
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package text
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestVisual(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		dir  Direction
+		want string
+	}{
+		{"ltr only", "abc def", DIRECTION_LTR, "abc def"},
+		{"rtl word in ltr", "abc אבג def", DIRECTION_LTR, "abc גבא def"},
+		{"ltr word in rtl", "אבג abc", DIRECTION_RTL, "abc גבא"},
+		{"auto rtl", "אבג abc", DIRECTION_AUTO, "abc גבא"},
+		{"auto ltr", "abc אבג", DIRECTION_AUTO, "abc גבא"},
+		{"trailing spaces", "אב  ", DIRECTION_LTR, "בא  "},
+
+		// numbers keep their order in the right-to-left text (W2-W7, I1-I2)
+		{"number in rtl", "א 12 ב", DIRECTION_RTL, "ב 12 א"},
+		{"number in rtl in ltr", "x א 12 ב", DIRECTION_LTR, "x ב 12 א"},
+		{"separators", "א 1,234.5 ב", DIRECTION_RTL, "ב 1,234.5 א"},
+		{"terminators", "א 50% ב", DIRECTION_RTL, "ב 50% א"},
+		{"currency", "א $12 ב", DIRECTION_RTL, "ב $12 א"},
+		{"arabic letters and number", "عدد 12", DIRECTION_RTL, "12 ددع"},
+		{"arabic digits", "abc ١٢٣", DIRECTION_LTR, "abc ١٢٣"},
+		{"european digits after arabic", "ع 12 ش", DIRECTION_LTR, "ش 12 ع"},
+
+		// N0: the brackets take the direction of their content and context
+		{"brackets rtl content", "א(ב)c", DIRECTION_LTR, "(ב)אc"},
+		{"brackets ltr content in rtl", "אב (cd) גד", DIRECTION_RTL, "דג (cd) בא"},
+		{"brackets mirrored", "א [ב] ג", DIRECTION_RTL, "ג [ב] א"},
+		{"brackets unpaired", "א (ב", DIRECTION_RTL, "ב) א"},
+
+		// isolates and embeddings, the formatting characters are not drawn
+		{"rli", "a \u2067אב cd\u2069 e", DIRECTION_LTR, "a cd בא e"},
+		{"no rli", "a אב cd e", DIRECTION_LTR, "a בא cd e"},
+		{"fsi rtl", "a \u2068אב cd\u2069 e", DIRECTION_LTR, "a cd בא e"},
+		{"fsi ltr", "a \u2068cd אב\u2069 e", DIRECTION_LTR, "a cd בא e"},
+		{"lri in rtl", "א \u2066bc 12\u2069 ב", DIRECTION_RTL, "ב bc 12 א"},
+		{"rle", "a \u202bb c\u202c d", DIRECTION_LTR, "a b c d"},
+		{"rlo", "a \u202ebc\u202c d", DIRECTION_LTR, "a cb d"},
+		{"lro in rtl", "א \u202dבג\u202c", DIRECTION_RTL, "בג א"},
+		{"unterminated isolate", "a \u2067אב", DIRECTION_LTR, "a בא"},
+		{"marks removed", "a\u200fb", DIRECTION_LTR, "ab"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Visual(tt.s, tt.dir); got != tt.want {
+				t.Errorf("Visual(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParagraphLevels(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		dir  Direction
+		want []uint8
+	}{
+		{"number in rtl", "א 12 ב", DIRECTION_RTL, []uint8{1, 1, 2, 2, 1, 1}},
+		{"arabic digits in ltr", "a ١٢", DIRECTION_LTR, []uint8{0, 0, 2, 2}},
+		{"european digits after arabic letter", "ع12", DIRECTION_LTR, []uint8{1, 2, 2}},
+		{"rle", "a\u202bb\u202c", DIRECTION_LTR, []uint8{0, 0, 2, 2}},
+		{"rli", "a\u2067b\u2069", DIRECTION_LTR, []uint8{0, 0, 2, 0}},
+		{"rtl paragraph", "ab", DIRECTION_RTL, []uint8{2, 2}},
+		// the characters removed by X9 have the level of the previous one
+		{"nested", "\u202bא\u202ab\u202c\u202c", DIRECTION_LTR, []uint8{0, 1, 1, 2, 2, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewParagraph(tt.s, tt.dir)
+			if !slices.Equal(p.levels, tt.want) {
+				t.Errorf("NewParagraph(%q) levels = %v, want %v", tt.s, p.levels, tt.want)
+			}
+		})
+	}
+}
+
+func TestVisualLine(t *testing.T) {
+	// the lines of one paragraph are reordered separately
+	p := NewParagraph("abc אבג דהו xyz", DIRECTION_LTR)
+	if got, want := p.Visual(0, 8), "abc גבא "; got != want {
+		t.Errorf("Visual(0, 8) = %q, want %q", got, want)
+	}
+	if got, want := p.Visual(8, p.Len()), "והד xyz"; got != want {
+		t.Errorf("Visual(8, %d) = %q, want %q", p.Len(), got, want)
+	}
+}
+
+func TestDetectDirection(t *testing.T) {
+	tests := []struct {
+		name   string
+		s      string
+		want   Direction
+		wantOK bool
+	}{
+		{"latin", "abc", DIRECTION_LTR, true},
+		{"hebrew", "אבג abc", DIRECTION_RTL, true},
+		{"arabic after digits", "12 عدد", DIRECTION_RTL, true},
+		{"isolate skipped", "\u2067אב\u2069 abc", DIRECTION_LTR, true},
+		{"digits only", "12.5 %", DIRECTION_LTR, false},
+		{"empty", "", DIRECTION_LTR, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := DetectDirection(tt.s)
+			if ok != tt.wantOK || ok && got != tt.want {
+				t.Errorf("DetectDirection(%q) = %v, %v, want %v, %v", tt.s, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestNeedsBidi(t *testing.T) {
+	tests := []struct {
+		s    string
+		want bool
+	}{
+		{"abc 123", false},
+		{"Привет, 漢字", false},
+		{"abc אב", true},
+		{"عدد", true},
+		{"١٢", true},
+		{"a\u2067b", true},
+		{"a\u200fb", true},
+	}
+
+	for _, tt := range tests {
+		if got := NeedsBidi(tt.s); got != tt.want {
+			t.Errorf("NeedsBidi(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+}
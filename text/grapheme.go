@@ -0,0 +1,99 @@
+package text
+
+import "unicode/utf8"
+
+// Grapheme_Cluster_Break classes of UAX #29
+const (
+	GB_OTHER = iota
+	GB_CR
+	GB_LF
+	GB_CONTROL
+	GB_EXTEND
+	GB_ZWJ
+	GB_RI
+	GB_PREPEND
+	GB_SPACING_MARK
+	GB_L
+	GB_V
+	GB_T
+	GB_LV
+	GB_LVT
+)
+
+func graphemeClass(r rune) uint8 {
+	return lookup(GRAPHEME_CLASSES, r, GB_OTHER)
+}
+
+func isPictographic(r rune) bool {
+	return lookup(EXTENDED_PICTOGRAPHIC, r, 0) == 1
+}
+
+// GraphemeBreaks returns the byte positions of the ends of the user-perceived characters
+// of the text by the rules of UAX #29, the text may be cut only at them
+func GraphemeBreaks(s string) []int {
+	var breaks []int
+	if s == "" {
+		return breaks
+	}
+
+	r0, size := utf8.DecodeRuneInString(s)
+	prev := graphemeClass(r0)
+	emoji := isPictographic(r0) // in an emoji sequence: ExtPict Extend* ZWJ?
+	regional := 0
+	if prev == GB_RI {
+		regional = 1
+	}
+
+	for i, r := range s[size:] {
+		cur := graphemeClass(r)
+
+		join := false
+		switch {
+		case prev == GB_CR && cur == GB_LF: // GB3
+			join = true
+		case prev == GB_CR || prev == GB_LF || prev == GB_CONTROL: // GB4
+		case cur == GB_CR || cur == GB_LF || cur == GB_CONTROL: // GB5
+		case prev == GB_L && (cur == GB_L || cur == GB_V || cur == GB_LV || cur == GB_LVT),
+			(prev == GB_LV || prev == GB_V) && (cur == GB_V || cur == GB_T),
+			(prev == GB_LVT || prev == GB_T) && cur == GB_T: // GB6, GB7, GB8
+			join = true
+		case cur == GB_EXTEND || cur == GB_ZWJ || cur == GB_SPACING_MARK || prev == GB_PREPEND: // GB9, GB9a, GB9b
+			join = true
+		case prev == GB_ZWJ && emoji && isPictographic(r): // GB11
+			join = true
+		case prev == GB_RI && cur == GB_RI && regional%2 == 1: // GB12, GB13
+			join = true
+		}
+
+		if !join {
+			breaks = append(breaks, size+i)
+		}
+
+		switch {
+		case isPictographic(r):
+			emoji = true
+		case (cur == GB_EXTEND || cur == GB_ZWJ) && prev != GB_ZWJ:
+		default:
+			emoji = false
+		}
+		if cur == GB_RI {
+			regional++
+		} else {
+			regional = 0
+		}
+		prev = cur
+	}
+
+	return append(breaks, len(s))
+}
+
+// Graphemes splits the text into the user-perceived characters
+func Graphemes(s string) []string {
+	var clusters []string
+	start := 0
+	for _, end := range GraphemeBreaks(s) {
+		clusters = append(clusters, s[start:end])
+		start = end
+	}
+	return clusters
+}
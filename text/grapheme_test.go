@@ -0,0 +1,43 @@
+package text
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestGraphemes(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want []string
+	}{
+		{"empty", "", nil},
+		{"cyrillic", "Мир", []string{"М", "и", "р"}},
+		{"combining accent", "е\u0301ж", []string{"е\u0301", "ж"}},
+		{"crlf", "a\r\nb", []string{"a", "\r\n", "b"}},
+		{"cjk", "漢字", []string{"漢", "字"}},
+		{"hangul jamo", "한ᄀ", []string{"한", "ᄀ"}},
+		{"emoji zwj", "👨‍👩‍👧‍👦!", []string{"👨‍👩‍👧‍👦", "!"}},
+		{"emoji zwj variation", "🏳️‍🌈🏳️", []string{"🏳️‍🌈", "🏳️"}},
+		{"zwj after a letter", "a\u200d👍", []string{"a\u200d", "👍"}},
+		{"emoji modifier", "👍🏽👍", []string{"👍🏽", "👍"}},
+		{"flags", "🇺🇦🇩🇪🇯", []string{"🇺🇦", "🇩🇪", "🇯"}},
+		{"prepend", "؀١", []string{"؀١"}},
+		{"spacing mark", "कि", []string{"कि"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Graphemes(tt.s); !slices.Equal(got, tt.want) {
+				t.Errorf("Graphemes(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGraphemeBreaks(t *testing.T) {
+	s := "д🇺🇦"
+	if got, want := GraphemeBreaks(s), []int{2, 10}; !slices.Equal(got, want) {
+		t.Errorf("GraphemeBreaks(%q) = %v, want %v", s, got, want)
+	}
+}
@@ -0,0 +1,221 @@
+package text
+
+import (
+	"bufio"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/rs/zerolog/log"
+)
+
+// The patterns of all the locales take about 320 KB of the binary, much less than the
+// fonts. A locale's patterns are parsed on its first use only, see GetHyphenator.
+//
+//go:embed hyphenation
+var hyphenationFiles embed.FS
+
+// HYPHENATION_MINS are the shortest beginnings and ends of the words left at the hyphen by locale
+var HYPHENATION_MINS = map[string][2]int{
+	"en": {2, 3},
+	"ru": {2, 2},
+	"uk": {2, 2},
+	"de": {2, 2},
+	"es": {2, 2},
+}
+
+// HYPHENATION_FOLDS are the letters hyphenated as the other ones by locale, the
+// Russian patterns have no ё
+var HYPHENATION_FOLDS = map[string]map[rune]rune{
+	"ru": {'ё': 'е'},
+}
+
+// Hyphenator finds the points where the words may be hyphenated by the Liang's patterns
+type Hyphenator struct {
+	patterns   map[string][]uint8 // letters -> values before, between and after them
+	exceptions map[string][]int   // word -> numbers of the letters before the hyphens
+	letters    map[rune]bool      // of the patterns
+	maxLen     int                // of the patterns in letters
+	fold       map[rune]rune      // see HYPHENATION_FOLDS
+	leftMin    int
+	rightMin   int
+}
+
+// hyphenators are loaded on the first use by locale
+var hyphenators = func() map[string]func() *Hyphenator {
+	m := make(map[string]func() *Hyphenator)
+	for locale := range HYPHENATION_MINS {
+		m[locale] = sync.OnceValue(func() *Hyphenator {
+			h, err := loadHyphenator(locale)
+			if err != nil {
+				log.Error().Err(err).Msgf("Failed to load the %s hyphenation", locale)
+			}
+			return h
+		})
+	}
+	return m
+}()
+
+// GetHyphenator returns the hyphenator of the locale, nil if there are no patterns for it
+func GetHyphenator(locale string) *Hyphenator {
+	if load, ok := hyphenators[locale]; ok {
+		return load()
+	}
+	return nil
+}
+
+// Hyphenate returns the byte positions where the word may be hyphenated by the patterns
+// of the locale, the English ones are used for the words in the letters not of the locale
+func Hyphenate(locale, word string) []int {
+	for _, l := range []string{locale, "en"} {
+		if h := GetHyphenator(l); h != nil && h.Covers(word) {
+			return h.Hyphenate(word)
+		}
+	}
+	return nil
+}
+
+func loadHyphenator(locale string) (*Hyphenator, error) {
+	mins := HYPHENATION_MINS[locale]
+	h := &Hyphenator{
+		patterns:   make(map[string][]uint8),
+		exceptions: make(map[string][]int),
+		letters:    make(map[rune]bool),
+		fold:       HYPHENATION_FOLDS[locale],
+		leftMin:    mins[0],
+		rightMin:   mins[1],
+	}
+
+	err := readLines(path.Join("hyphenation", locale+".pat"), func(pattern string) error {
+		var letters []rune
+		values := []uint8{0}
+		for _, r := range pattern {
+			if r >= '0' && r <= '9' {
+				values[len(values)-1] = uint8(r - '0')
+				continue
+			}
+			letters = append(letters, r)
+			values = append(values, 0)
+			if r != '.' {
+				h.letters[r] = true
+			}
+		}
+		if len(letters) == 0 {
+			return fmt.Errorf("bad pattern %q", pattern)
+		}
+		h.patterns[string(letters)] = values
+		h.maxLen = max(h.maxLen, len(letters))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = readLines(path.Join("hyphenation", locale+".hyp"), func(word string) error {
+		var hyphens []int
+		n := 0
+		for _, r := range word {
+			if r == '-' {
+				hyphens = append(hyphens, n)
+			} else {
+				n++
+			}
+		}
+		h.exceptions[strings.ReplaceAll(word, "-", "")] = hyphens
+		return nil
+	})
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// readLines calls f for the lines of the embedded file without the comments
+func readLines(name string, f func(string) error) error {
+	file, err := hyphenationFiles.Open(name)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "%") {
+			continue
+		}
+		if err := f(line); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return scanner.Err()
+}
+
+// Covers reports whether the patterns have all the letters of the word
+func (h *Hyphenator) Covers(word string) bool {
+	for _, r := range word {
+		if !h.letters[h.letter(r)] {
+			return false
+		}
+	}
+	return word != ""
+}
+
+// letter returns the letter of the patterns for the character of the word
+func (h *Hyphenator) letter(r rune) rune {
+	r = unicode.ToLower(r)
+	if f, ok := h.fold[r]; ok {
+		return f
+	}
+	return r
+}
+
+// Hyphenate returns the byte positions in the word where it may be hyphenated
+func (h *Hyphenator) Hyphenate(word string) []int {
+	var offsets []int // of the letters
+	var letters []rune
+	for i, r := range word {
+		offsets = append(offsets, i)
+		letters = append(letters, h.letter(r))
+	}
+	n := len(letters)
+	if n < h.leftMin+h.rightMin {
+		return nil
+	}
+
+	var hyphens []int
+	if exception, ok := h.exceptions[string(letters)]; ok {
+		for _, k := range exception {
+			hyphens = append(hyphens, offsets[k])
+		}
+		return hyphens
+	}
+
+	dotted := append(append([]rune{'.'}, letters...), '.')
+	values := make([]uint8, len(dotted)+1) // values[k] is before dotted[k]
+	for i := range dotted {
+		for j := i + 1; j <= len(dotted) && j-i <= h.maxLen; j++ {
+			pattern, ok := h.patterns[string(dotted[i:j])]
+			if !ok {
+				continue
+			}
+			for k, v := range pattern {
+				values[i+k] = max(values[i+k], v)
+			}
+		}
+	}
+
+	// the hyphen before the letter k is before dotted[k+1]
+	for k := h.leftMin; k <= n-h.rightMin; k++ {
+		if values[k+1]%2 == 1 {
+			hyphens = append(hyphens, offsets[k])
+		}
+	}
+	return hyphens
+}
@@ -0,0 +1,168 @@
+package text
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// hyphenated returns the word with "-" at the hyphenation points of the locale
+func hyphenated(locale, word string) string {
+	var b strings.Builder
+	last := 0
+	for _, i := range Hyphenate(locale, word) {
+		b.WriteString(word[last:i])
+		b.WriteString("-")
+		last = i
+	}
+	b.WriteString(word[last:])
+	return b.String()
+}
+
+func TestHyphenate(t *testing.T) {
+	// the points of the TeX patterns, as hyphenated by LaTeX with the babel language of the locale
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{"en", "al-go-rithm"},
+		{"en", "type-set-ting"},
+		{"en", "trans-ac-tion"},
+		{"en", "bal-ance"},
+		{"en", "in-for-ma-tion"},
+		{"en", "spon-sor-ship"},
+		{"en", "Al-go-rithm"},
+		// the exceptions
+		{"en", "ta-ble"},
+		{"en", "as-so-ciate"},
+		{"en", "ref-or-ma-tion"},
+		{"en", "project"},
+		{"en", "present"},
+		{"en", "a-spher-i-cal"},
+
+		{"ru", "про-грам-ми-ро-ва-ние"},
+		{"ru", "тран-зак-ция"},
+		{"ru", "ба-ланс"},
+		{"ru", "под-пис-ка"},
+		{"ru", "без-опас-ность"},
+		{"ru", "от-чёт"},
+		{"ru", "От-чёт"},
+
+		{"uk", "кни-га"},
+		{"uk", "ву-ли-ця"},
+		{"uk", "Ки-їв"},
+		{"uk", "під-при-єм-ство"},
+		{"uk", "укра-їн-ський"},
+
+		{"de", "Sil-ben-tren-nung"},
+		{"de", "Ver-si-che-rung"},
+		{"de", "Zeit-raum"},
+		{"de", "Kon-to-aus-zug"},
+		{"de", "Stra-ße"},
+
+		{"es", "ca-ba-llo"},
+		{"es", "cho-co-la-te"},
+		{"es", "ejem-plo"},
+		{"es", "pe-rro"},
+		{"es", "gui-ta-rra"},
+		{"es", "in-for-me"},
+
+		// the English patterns for the Latin words in the other locales
+		{"ru", "re-port"},
+		{"zh", "re-port"},
+		{"ar", "al-go-rithm"},
+	}
+
+	for _, tt := range tests {
+		word := strings.ReplaceAll(tt.want, "-", "")
+		t.Run(tt.locale+" "+word, func(t *testing.T) {
+			if got := hyphenated(tt.locale, word); got != tt.want {
+				t.Errorf("Hyphenate(%s, %q) = %q, want %q", tt.locale, word, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHyphenateMins(t *testing.T) {
+	tests := []struct {
+		locale string
+		word   string
+		want   []int
+	}{
+		{"en", "", nil},
+		{"en", "cat", nil},
+		{"en", "ta", nil},
+		{"en", "table", []int{2}},                // the shortest word with 2 letters before and 3 after
+		{"ru", "ба-ланс", nil},                   // the hyphen is not a letter of the patterns
+		{"ru", "ёж", nil},                        // shorter than 2 + 2
+		{"ru", "баланс", []int{4}},               // the byte offset
+		{"ru", "abcабв", nil},                    // neither patterns cover both scripts
+		{"ar", "سلام", nil},                      // no patterns
+		{"de", "Straße", []int{4}},               // ß is in the patterns
+		{"es", "informe", []int{2, 5}},           // 2 letters at the ends
+		{"uk", "з'їзд", nil},                     // one syllable
+		{"uk", "підприємство", []int{6, 12, 16}}, // 2 bytes a letter
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale+" "+tt.word, func(t *testing.T) {
+			if got := Hyphenate(tt.locale, tt.word); !slices.Equal(got, tt.want) {
+				t.Errorf("Hyphenate(%s, %q) = %v, want %v", tt.locale, tt.word, got, tt.want)
+			}
+		})
+	}
+
+	// no locale leaves fewer letters at the hyphen than its minimums
+	words := []string{"internationalization", "неудовлетворительность", "перевантаженість", "Donaudampfschifffahrt", "anticonstitucionalmente"}
+	for locale, mins := range HYPHENATION_MINS {
+		for _, word := range words {
+			for _, i := range Hyphenate(locale, word) {
+				if before, after := utf8.RuneCountInString(word[:i]), utf8.RuneCountInString(word[i:]); before < mins[0] || after < mins[1] {
+					t.Errorf("Hyphenate(%s, %q) leaves %d and %d letters at %d", locale, word, before, after, i)
+				}
+			}
+		}
+	}
+}
+
+func TestCovers(t *testing.T) {
+	tests := []struct {
+		locale string
+		word   string
+		want   bool
+	}{
+		{"en", "report", true},
+		{"en", "Report", true},
+		{"en", "", false},
+		{"en", "café", false},
+		{"en", "re-port", false},
+		{"en", "report1", false},
+		{"ru", "баланс", true},
+		{"ru", "Баланс", true},
+		{"ru", "ёлка", true},
+		{"ru", "report", false},
+		{"ru", "київ", false},
+		{"uk", "київ", true},
+		{"uk", "з'їзд", true},
+		{"uk", "ёлка", false},
+		{"de", "Straße", true},
+		{"de", "Übersicht", true},
+		{"es", "transacción", true},
+		{"es", "año", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale+" "+tt.word, func(t *testing.T) {
+			if got := GetHyphenator(tt.locale).Covers(tt.word); got != tt.want {
+				t.Errorf("Covers(%s, %q) = %v, want %v", tt.locale, tt.word, got, tt.want)
+			}
+		})
+	}
+
+	for _, locale := range []string{"ar", "zh", ""} {
+		if h := GetHyphenator(locale); h != nil {
+			t.Errorf("GetHyphenator(%q) = %v, want nil", locale, h)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package text
+
+import (
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+//go:generate perl tables.pl
+
+// Line_Break classes of UAX #14
+const (
+	LB_XX = iota // unknown
+	LB_AI
+	LB_AL
+	LB_B2
+	LB_BA
+	LB_BB
+	LB_BK
+	LB_CB
+	LB_CJ
+	LB_CL
+	LB_CM
+	LB_CP
+	LB_CR
+	LB_EB
+	LB_EM
+	LB_EX
+	LB_GL
+	LB_H2
+	LB_H3
+	LB_HL
+	LB_HY
+	LB_ID
+	LB_IN
+	LB_IS
+	LB_JL
+	LB_JT
+	LB_JV
+	LB_LF
+	LB_NL
+	LB_NS
+	LB_NU
+	LB_OP
+	LB_PO
+	LB_PR
+	LB_QU
+	LB_RI
+	LB_SA
+	LB_SG
+	LB_SP
+	LB_SY
+	LB_WJ
+	LB_ZW
+	LB_ZWJ
+)
+
+// classRange is the class of the characters from lo to hi
+type classRange struct {
+	lo, hi rune
+	class  uint8
+}
+
+// lookup returns the class of the rune in the sorted ranges, def if it is in none
+func lookup(ranges []classRange, r rune, def uint8) uint8 {
+	i := sort.Search(len(ranges), func(i int) bool { return ranges[i].hi >= r })
+	if i < len(ranges) && ranges[i].lo <= r {
+		return ranges[i].class
+	}
+	return def
+}
+
+// Break is a line break opportunity, the line may end before the byte Pos of the text
+type Break struct {
+	Pos       int
+	Mandatory bool // after a line feed or another hard break
+}
+
+// lineBreakClass returns the class of the rune resolved by the rule LB1
+func lineBreakClass(r rune) uint8 {
+	switch c := lookup(LINE_BREAK_CLASSES, r, LB_XX); c {
+	case LB_AI, LB_SG, LB_XX:
+		return LB_AL
+	case LB_SA:
+		if unicode.In(r, unicode.Mn, unicode.Mc) {
+			return LB_CM
+		}
+		return LB_AL
+	case LB_CJ:
+		return LB_NS
+	default:
+		return c
+	}
+}
+
+// LineBreaks returns the opportunities to break the lines of the text by the rules of
+// UAX #14, the end of the text is the last one
+func LineBreaks(s string) []Break {
+	var breaks []Break
+	if s == "" {
+		return breaks
+	}
+
+	r0, size := utf8.DecodeRuneInString(s)
+	raw := lineBreakClass(r0) // class of the last character
+	prev := raw               // class of the last character without the combining marks, LB9
+	if prev == LB_CM || prev == LB_ZWJ {
+		prev = LB_AL // LB10
+	}
+	var before uint8 = LB_XX // class of the character before prev
+	nonSpace := prev         // class of the last character that is not a space
+	regional := 0            // number of the regional indicators in a row
+	prevRune := r0           // the character of prev
+	if prev == LB_RI {
+		regional = 1
+	}
+
+	for i, r := range s[size:] {
+		pos := size + i
+		cur := lineBreakClass(r)
+
+		allowed, mandatory := false, false
+		attached := false // a combining mark kept with the last character, LB9
+
+		switch {
+		case raw == LB_BK || raw == LB_LF || raw == LB_NL || raw == LB_CR && cur != LB_LF: // LB4, LB5
+			mandatory = true
+		case raw == LB_CR: // LB5
+		case cur == LB_BK || cur == LB_CR || cur == LB_LF || cur == LB_NL: // LB6
+		case cur == LB_SP || cur == LB_ZW: // LB7
+		case nonSpace == LB_ZW: // LB8
+			allowed = true
+		case raw == LB_ZWJ: // LB8a
+			attached = (cur == LB_CM || cur == LB_ZWJ) && prev != LB_SP && prev != LB_ZW
+		case (cur == LB_CM || cur == LB_ZWJ) && prev != LB_SP && prev != LB_ZW: // LB9
+			attached = true
+		default:
+			if cur == LB_CM || cur == LB_ZWJ {
+				cur = LB_AL // LB10
+			}
+			allowed = pairBreak(before, prev, nonSpace, regional, prevRune, cur, r)
+		}
+
+		if mandatory || allowed {
+			breaks = append(breaks, Break{Pos: pos, Mandatory: mandatory})
+		}
+
+		raw = cur
+		if attached {
+			continue
+		}
+
+		if cur == LB_RI && prev == LB_RI {
+			regional++
+		} else if cur == LB_RI {
+			regional = 1
+		} else {
+			regional = 0
+		}
+		before, prev, prevRune = prev, cur, r
+		if cur == LB_CM || cur == LB_ZWJ {
+			prev = LB_AL // LB10
+		}
+		if prev != LB_SP {
+			nonSpace = prev
+		}
+	}
+
+	return append(breaks, Break{Pos: len(s)})
+}
+
+// pairBreak reports whether the line may break between the characters of the classes
+// prev and cur by the rules LB11 to LB31
+func pairBreak(before, prev, nonSpace uint8, regional int, prevRune rune, cur uint8, r rune) bool {
+	in := func(c uint8, classes ...uint8) bool {
+		for _, x := range classes {
+			if c == x {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch {
+	case cur == LB_WJ || prev == LB_WJ: // LB11
+		return false
+	case prev == LB_GL: // LB12
+		return false
+	case cur == LB_GL && !in(prev, LB_SP, LB_BA, LB_HY): // LB12a
+		return false
+	case in(cur, LB_CL, LB_CP, LB_EX, LB_IS, LB_SY): // LB13
+		return false
+	case nonSpace == LB_OP: // LB14
+		return false
+	case nonSpace == LB_QU && cur == LB_OP: // LB15
+		return false
+	case in(nonSpace, LB_CL, LB_CP) && cur == LB_NS: // LB16
+		return false
+	case nonSpace == LB_B2 && cur == LB_B2: // LB17
+		return false
+	case prev == LB_SP: // LB18
+		return true
+	case cur == LB_QU || prev == LB_QU: // LB19
+		return false
+	case cur == LB_CB || prev == LB_CB: // LB20
+		return true
+	case in(cur, LB_BA, LB_HY, LB_NS) || prev == LB_BB: // LB21
+		return false
+	case before == LB_HL && in(prev, LB_HY, LB_BA): // LB21a
+		return false
+	case prev == LB_SY && cur == LB_HL: // LB21b
+		return false
+	case cur == LB_IN: // LB22
+		return false
+	case in(prev, LB_AL, LB_HL) && cur == LB_NU, prev == LB_NU && in(cur, LB_AL, LB_HL): // LB23
+		return false
+	case prev == LB_PR && in(cur, LB_ID, LB_EB, LB_EM), in(prev, LB_ID, LB_EB, LB_EM) && cur == LB_PO: // LB23a
+		return false
+	case in(prev, LB_PR, LB_PO) && in(cur, LB_AL, LB_HL), in(prev, LB_AL, LB_HL) && in(cur, LB_PR, LB_PO): // LB24
+		return false
+	case in(prev, LB_CL, LB_CP, LB_NU) && in(cur, LB_PO, LB_PR),
+		in(prev, LB_PO, LB_PR) && in(cur, LB_OP, LB_NU),
+		in(prev, LB_HY, LB_IS, LB_NU, LB_SY) && cur == LB_NU: // LB25
+		return false
+	case prev == LB_JL && in(cur, LB_JL, LB_JV, LB_H2, LB_H3),
+		in(prev, LB_JV, LB_H2) && in(cur, LB_JV, LB_JT),
+		in(prev, LB_JT, LB_H3) && cur == LB_JT: // LB26
+		return false
+	case in(prev, LB_JL, LB_JV, LB_JT, LB_H2, LB_H3) && cur == LB_PO,
+		prev == LB_PR && in(cur, LB_JL, LB_JV, LB_JT, LB_H2, LB_H3): // LB27
+		return false
+	case in(prev, LB_AL, LB_HL) && in(cur, LB_AL, LB_HL): // LB28
+		return false
+	case prev == LB_IS && in(cur, LB_AL, LB_HL): // LB29
+		return false
+	case in(prev, LB_AL, LB_HL, LB_NU) && cur == LB_OP && lookup(WIDE_OPENINGS, r, LB_XX) == LB_XX,
+		prev == LB_CP && in(cur, LB_AL, LB_HL, LB_NU): // LB30
+		return false
+	case prev == LB_RI && cur == LB_RI && regional%2 == 1: // LB30a
+		return false
+	case prev == LB_EB && cur == LB_EM,
+		cur == LB_EM && isPictographic(prevRune) && unassigned(prevRune): // LB30b
+		return false
+	}
+	return true // LB31
+}
+
+// unassigned reports whether the rune is not assigned a character yet
+func unassigned(r rune) bool {
+	for _, t := range unicode.Categories {
+		if unicode.Is(t, r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,75 @@
+package text
+
+import (
+	"slices"
+	"testing"
+)
+
+// segments splits the text at the breaks
+func segments(s string, breaks []Break) []string {
+	var r []string
+	start := 0
+	for _, b := range breaks {
+		r = append(r, s[start:b.Pos])
+		start = b.Pos
+	}
+	return r
+}
+
+func TestLineBreaks(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want []string
+	}{
+		{"empty", "", nil},
+		{"latin", "hello world", []string{"hello ", "world"}},
+		{"cyrillic", "Привет, мир", []string{"Привет, ", "мир"}},
+		{"cyrillic hyphen", "кто-нибудь придёт", []string{"кто-", "нибудь ", "придёт"}},
+		{"spaces", "да  нет", []string{"да  ", "нет"}},
+		{"no-break space", "100\u00a0₽ всего", []string{"100\u00a0₽ ", "всего"}},
+		{"numbers", "$12.50 (1,000)", []string{"$12.50 ", "(1,000)"}},
+		{"cjk", "日本語", []string{"日", "本", "語"}},
+		{"cjk punctuation", "日本。「語」", []string{"日", "本。", "「語」"}},
+		{"cjk small kana", "ちょっと", []string{"ちょっ", "と"}},
+		{"cjk prolonged sound", "コーヒー", []string{"コー", "ヒー"}},
+		{"cjk and latin", "中文abc字", []string{"中", "文", "abc", "字"}},
+		{"emoji zwj", "👨‍👩‍👧 family", []string{"👨‍👩‍👧 ", "family"}},
+		{"emoji zwj in a row", "👨‍👩‍👧👩‍❤️‍👨", []string{"👨‍👩‍👧", "👩‍❤️‍👨"}},
+		{"emoji modifier", "👍🏽👍", []string{"👍🏽", "👍"}},
+		{"flags", "🇺🇦🇩🇪🇯", []string{"🇺🇦", "🇩🇪", "🇯"}},
+		{"combining mark", "é a", []string{"é ", "a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			breaks := LineBreaks(tt.s)
+			if got := segments(tt.s, breaks); !slices.Equal(got, tt.want) {
+				t.Errorf("LineBreaks(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+			for _, b := range breaks {
+				if b.Mandatory {
+					t.Errorf("LineBreaks(%q) has a mandatory break at %d", tt.s, b.Pos)
+				}
+			}
+		})
+	}
+}
+
+func TestLineBreaksMandatory(t *testing.T) {
+	tests := []struct {
+		s    string
+		want []Break
+	}{
+		{"a\nb", []Break{{2, true}, {3, false}}},
+		{"a\r\nb", []Break{{3, true}, {4, false}}},
+		{"строка б", []Break{{15, true}, {17, false}}},
+		{"a\n", []Break{{2, false}}},
+	}
+
+	for _, tt := range tests {
+		if got := LineBreaks(tt.s); !slices.Equal(got, tt.want) {
+			t.Errorf("LineBreaks(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package text
+
+// MIRRORS are the characters drawn mirrored in the right-to-left runs (rule L4),
+// the common part of BidiMirroring.txt
+var MIRRORS = func() map[rune]rune {
+	pairs := [][2]rune{
+		{'(', ')'}, {'<', '>'}, {'[', ']'}, {'{', '}'}, {'«', '»'},
+		{'‹', '›'}, {'⁅', '⁆'}, {'⁽', '⁾'}, {'₍', '₎'},
+		{'∈', '∋'}, {'∉', '∌'}, {'∊', '∍'}, {'∼', '∽'}, {'≃', '⋍'},
+		{'≤', '≥'}, {'≦', '≧'}, {'≪', '≫'}, {'≮', '≯'}, {'≰', '≱'},
+		{'≲', '≳'}, {'≺', '≻'}, {'⊂', '⊃'}, {'⊄', '⊅'}, {'⊆', '⊇'},
+		{'⊏', '⊐'}, {'⊑', '⊒'}, {'⊢', '⊣'}, {'⋉', '⋊'}, {'⋐', '⋑'},
+		{'⌈', '⌉'}, {'⌊', '⌋'}, {'\u2329', '\u232a'},
+		{'❨', '❩'}, {'❪', '❫'}, {'❬', '❭'}, {'❮', '❯'}, {'❰', '❱'},
+		{'❲', '❳'}, {'❴', '❵'}, {'⟦', '⟧'}, {'⟨', '⟩'}, {'⟪', '⟫'},
+		{'⦃', '⦄'}, {'⦅', '⦆'}, {'⦇', '⦈'}, {'⦉', '⦊'}, {'⦋', '⦌'},
+		{'\u3008', '\u3009'}, {'《', '》'}, {'「', '」'}, {'『', '』'}, {'【', '】'},
+		{'〔', '〕'}, {'〖', '〗'}, {'〘', '〙'}, {'〚', '〛'},
+		{'﹙', '﹚'}, {'﹛', '﹜'}, {'﹝', '﹞'}, {'﹤', '﹥'},
+		{'（', '）'}, {'＜', '＞'}, {'［', '］'}, {'｛', '｝'}, {'｟', '｠'}, {'｢', '｣'},
+	}
+
+	m := make(map[rune]rune, 2*len(pairs))
+	for _, p := range pairs {
+		m[p[0]], m[p[1]] = p[1], p[0]
+	}
+	return m
+}()
@@ -0,0 +1,4271 @@
+// Code generated by tables.pl from Unicode 14.0.0. DO NOT EDIT.
+
+package text
+
+// LINE_BREAK_CLASSES are the Line_Break property of the characters, XX if none
+var LINE_BREAK_CLASSES = []classRange{
+	{0x0000, 0x0008, LB_CM},
+	{0x0009, 0x0009, LB_BA},
+	{0x000A, 0x000A, LB_LF},
+	{0x000B, 0x000C, LB_BK},
+	{0x000D, 0x000D, LB_CR},
+	{0x000E, 0x001F, LB_CM},
+	{0x0020, 0x0020, LB_SP},
+	{0x0021, 0x0021, LB_EX},
+	{0x0022, 0x0022, LB_QU},
+	{0x0023, 0x0023, LB_AL},
+	{0x0024, 0x0024, LB_PR},
+	{0x0025, 0x0025, LB_PO},
+	{0x0026, 0x0026, LB_AL},
+	{0x0027, 0x0027, LB_QU},
+	{0x0028, 0x0028, LB_OP},
+	{0x0029, 0x0029, LB_CP},
+	{0x002A, 0x002A, LB_AL},
+	{0x002B, 0x002B, LB_PR},
+	{0x002C, 0x002C, LB_IS},
+	{0x002D, 0x002D, LB_HY},
+	{0x002E, 0x002E, LB_IS},
+	{0x002F, 0x002F, LB_SY},
+	{0x0030, 0x0039, LB_NU},
+	{0x003A, 0x003B, LB_IS},
+	{0x003C, 0x003E, LB_AL},
+	{0x003F, 0x003F, LB_EX},
+	{0x0040, 0x005A, LB_AL},
+	{0x005B, 0x005B, LB_OP},
+	{0x005C, 0x005C, LB_PR},
+	{0x005D, 0x005D, LB_CP},
+	{0x005E, 0x007A, LB_AL},
+	{0x007B, 0x007B, LB_OP},
+	{0x007C, 0x007C, LB_BA},
+	{0x007D, 0x007D, LB_CL},
+	{0x007E, 0x007E, LB_AL},
+	{0x007F, 0x0084, LB_CM},
+	{0x0085, 0x0085, LB_NL},
+	{0x0086, 0x009F, LB_CM},
+	{0x00A0, 0x00A0, LB_GL},
+	{0x00A1, 0x00A1, LB_OP},
+	{0x00A2, 0x00A2, LB_PO},
+	{0x00A3, 0x00A5, LB_PR},
+	{0x00A6, 0x00A6, LB_AL},
+	{0x00A7, 0x00A8, LB_AI},
+	{0x00A9, 0x00A9, LB_AL},
+	{0x00AA, 0x00AA, LB_AI},
+	{0x00AB, 0x00AB, LB_QU},
+	{0x00AC, 0x00AC, LB_AL},
+	{0x00AD, 0x00AD, LB_BA},
+	{0x00AE, 0x00AF, LB_AL},
+	{0x00B0, 0x00B0, LB_PO},
+	{0x00B1, 0x00B1, LB_PR},
+	{0x00B2, 0x00B3, LB_AI},
+	{0x00B4, 0x00B4, LB_BB},
+	{0x00B5, 0x00B5, LB_AL},
+	{0x00B6, 0x00BA, LB_AI},
+	{0x00BB, 0x00BB, LB_QU},
+	{0x00BC, 0x00BE, LB_AI},
+	{0x00BF, 0x00BF, LB_OP},
+	{0x00C0, 0x00D6, LB_AL},
+	{0x00D7, 0x00D7, LB_AI},
+	{0x00D8, 0x00F6, LB_AL},
+	{0x00F7, 0x00F7, LB_AI},
+	{0x00F8, 0x02C6, LB_AL},
+	{0x02C7, 0x02C7, LB_AI},
+	{0x02C8, 0x02C8, LB_BB},
+	{0x02C9, 0x02CB, LB_AI},
+	{0x02CC, 0x02CC, LB_BB},
+	{0x02CD, 0x02CD, LB_AI},
+	{0x02CE, 0x02CF, LB_AL},
+	{0x02D0, 0x02D0, LB_AI},
+	{0x02D1, 0x02D7, LB_AL},
+	{0x02D8, 0x02DB, LB_AI},
+	{0x02DC, 0x02DC, LB_AL},
+	{0x02DD, 0x02DD, LB_AI},
+	{0x02DE, 0x02DE, LB_AL},
+	{0x02DF, 0x02DF, LB_BB},
+	{0x02E0, 0x02FF, LB_AL},
+	{0x0300, 0x034E, LB_CM},
+	{0x034F, 0x034F, LB_GL},
+	{0x0350, 0x035B, LB_CM},
+	{0x035C, 0x0362, LB_GL},
+	{0x0363, 0x036F, LB_CM},
+	{0x0370, 0x0377, LB_AL},
+	{0x037A, 0x037D, LB_AL},
+	{0x037E, 0x037E, LB_IS},
+	{0x037F, 0x037F, LB_AL},
+	{0x0384, 0x038A, LB_AL},
+	{0x038C, 0x038C, LB_AL},
+	{0x038E, 0x03A1, LB_AL},
+	{0x03A3, 0x0482, LB_AL},
+	{0x0483, 0x0489, LB_CM},
+	{0x048A, 0x052F, LB_AL},
+	{0x0531, 0x0556, LB_AL},
+	{0x0559, 0x0588, LB_AL},
+	{0x0589, 0x0589, LB_IS},
+	{0x058A, 0x058A, LB_BA},
+	{0x058D, 0x058E, LB_AL},
+	{0x058F, 0x058F, LB_PR},
+	{0x0591, 0x05BD, LB_CM},
+	{0x05BE, 0x05BE, LB_BA},
+	{0x05BF, 0x05BF, LB_CM},
+	{0x05C0, 0x05C0, LB_AL},
+	{0x05C1, 0x05C2, LB_CM},
+	{0x05C3, 0x05C3, LB_AL},
+	{0x05C4, 0x05C5, LB_CM},
+	{0x05C6, 0x05C6, LB_EX},
+	{0x05C7, 0x05C7, LB_CM},
+	{0x05D0, 0x05EA, LB_HL},
+	{0x05EF, 0x05F2, LB_HL},
+	{0x05F3, 0x05F4, LB_AL},
+	{0x0600, 0x0608, LB_AL},
+	{0x0609, 0x060B, LB_PO},
+	{0x060C, 0x060D, LB_IS},
+	{0x060E, 0x060F, LB_AL},
+	{0x0610, 0x061A, LB_CM},
+	{0x061B, 0x061B, LB_EX},
+	{0x061C, 0x061C, LB_CM},
+	{0x061D, 0x061F, LB_EX},
+	{0x0620, 0x064A, LB_AL},
+	{0x064B, 0x065F, LB_CM},
+	{0x0660, 0x0669, LB_NU},
+	{0x066A, 0x066A, LB_PO},
+	{0x066B, 0x066C, LB_NU},
+	{0x066D, 0x066F, LB_AL},
+	{0x0670, 0x0670, LB_CM},
+	{0x0671, 0x06D3, LB_AL},
+	{0x06D4, 0x06D4, LB_EX},
+	{0x06D5, 0x06D5, LB_AL},
+	{0x06D6, 0x06DC, LB_CM},
+	{0x06DD, 0x06DE, LB_AL},
+	{0x06DF, 0x06E4, LB_CM},
+	{0x06E5, 0x06E6, LB_AL},
+	{0x06E7, 0x06E8, LB_CM},
+	{0x06E9, 0x06E9, LB_AL},
+	{0x06EA, 0x06ED, LB_CM},
+	{0x06EE, 0x06EF, LB_AL},
+	{0x06F0, 0x06F9, LB_NU},
+	{0x06FA, 0x070D, LB_AL},
+	{0x070F, 0x0710, LB_AL},
+	{0x0711, 0x0711, LB_CM},
+	{0x0712, 0x072F, LB_AL},
+	{0x0730, 0x074A, LB_CM},
+	{0x074D, 0x07A5, LB_AL},
+	{0x07A6, 0x07B0, LB_CM},
+	{0x07B1, 0x07B1, LB_AL},
+	{0x07C0, 0x07C9, LB_NU},
+	{0x07CA, 0x07EA, LB_AL},
+	{0x07EB, 0x07F3, LB_CM},
+	{0x07F4, 0x07F7, LB_AL},
+	{0x07F8, 0x07F8, LB_IS},
+	{0x07F9, 0x07F9, LB_EX},
+	{0x07FA, 0x07FA, LB_AL},
+	{0x07FD, 0x07FD, LB_CM},
+	{0x07FE, 0x07FF, LB_PR},
+	{0x0800, 0x0815, LB_AL},
+	{0x0816, 0x0819, LB_CM},
+	{0x081A, 0x081A, LB_AL},
+	{0x081B, 0x0823, LB_CM},
+	{0x0824, 0x0824, LB_AL},
+	{0x0825, 0x0827, LB_CM},
+	{0x0828, 0x0828, LB_AL},
+	{0x0829, 0x082D, LB_CM},
+	{0x0830, 0x083E, LB_AL},
+	{0x0840, 0x0858, LB_AL},
+	{0x0859, 0x085B, LB_CM},
+	{0x085E, 0x085E, LB_AL},
+	{0x0860, 0x086A, LB_AL},
+	{0x0870, 0x088E, LB_AL},
+	{0x0890, 0x0891, LB_AL},
+	{0x0898, 0x089F, LB_CM},
+	{0x08A0, 0x08C9, LB_AL},
+	{0x08CA, 0x08E1, LB_CM},
+	{0x08E2, 0x08E2, LB_AL},
+	{0x08E3, 0x0903, LB_CM},
+	{0x0904, 0x0939, LB_AL},
+	{0x093A, 0x093C, LB_CM},
+	{0x093D, 0x093D, LB_AL},
+	{0x093E, 0x094F, LB_CM},
+	{0x0950, 0x0950, LB_AL},
+	{0x0951, 0x0957, LB_CM},
+	{0x0958, 0x0961, LB_AL},
+	{0x0962, 0x0963, LB_CM},
+	{0x0964, 0x0965, LB_BA},
+	{0x0966, 0x096F, LB_NU},
+	{0x0970, 0x0980, LB_AL},
+	{0x0981, 0x0983, LB_CM},
+	{0x0985, 0x098C, LB_AL},
+	{0x098F, 0x0990, LB_AL},
+	{0x0993, 0x09A8, LB_AL},
+	{0x09AA, 0x09B0, LB_AL},
+	{0x09B2, 0x09B2, LB_AL},
+	{0x09B6, 0x09B9, LB_AL},
+	{0x09BC, 0x09BC, LB_CM},
+	{0x09BD, 0x09BD, LB_AL},
+	{0x09BE, 0x09C4, LB_CM},
+	{0x09C7, 0x09C8, LB_CM},
+	{0x09CB, 0x09CD, LB_CM},
+	{0x09CE, 0x09CE, LB_AL},
+	{0x09D7, 0x09D7, LB_CM},
+	{0x09DC, 0x09DD, LB_AL},
+	{0x09DF, 0x09E1, LB_AL},
+	{0x09E2, 0x09E3, LB_CM},
+	{0x09E6, 0x09EF, LB_NU},
+	{0x09F0, 0x09F1, LB_AL},
+	{0x09F2, 0x09F3, LB_PO},
+	{0x09F4, 0x09F8, LB_AL},
+	{0x09F9, 0x09F9, LB_PO},
+	{0x09FA, 0x09FA, LB_AL},
+	{0x09FB, 0x09FB, LB_PR},
+	{0x09FC, 0x09FD, LB_AL},
+	{0x09FE, 0x09FE, LB_CM},
+	{0x0A01, 0x0A03, LB_CM},
+	{0x0A05, 0x0A0A, LB_AL},
+	{0x0A0F, 0x0A10, LB_AL},
+	{0x0A13, 0x0A28, LB_AL},
+	{0x0A2A, 0x0A30, LB_AL},
+	{0x0A32, 0x0A33, LB_AL},
+	{0x0A35, 0x0A36, LB_AL},
+	{0x0A38, 0x0A39, LB_AL},
+	{0x0A3C, 0x0A3C, LB_CM},
+	{0x0A3E, 0x0A42, LB_CM},
+	{0x0A47, 0x0A48, LB_CM},
+	{0x0A4B, 0x0A4D, LB_CM},
+	{0x0A51, 0x0A51, LB_CM},
+	{0x0A59, 0x0A5C, LB_AL},
+	{0x0A5E, 0x0A5E, LB_AL},
+	{0x0A66, 0x0A6F, LB_NU},
+	{0x0A70, 0x0A71, LB_CM},
+	{0x0A72, 0x0A74, LB_AL},
+	{0x0A75, 0x0A75, LB_CM},
+	{0x0A76, 0x0A76, LB_AL},
+	{0x0A81, 0x0A83, LB_CM},
+	{0x0A85, 0x0A8D, LB_AL},
+	{0x0A8F, 0x0A91, LB_AL},
+	{0x0A93, 0x0AA8, LB_AL},
+	{0x0AAA, 0x0AB0, LB_AL},
+	{0x0AB2, 0x0AB3, LB_AL},
+	{0x0AB5, 0x0AB9, LB_AL},
+	{0x0ABC, 0x0ABC, LB_CM},
+	{0x0ABD, 0x0ABD, LB_AL},
+	{0x0ABE, 0x0AC5, LB_CM},
+	{0x0AC7, 0x0AC9, LB_CM},
+	{0x0ACB, 0x0ACD, LB_CM},
+	{0x0AD0, 0x0AD0, LB_AL},
+	{0x0AE0, 0x0AE1, LB_AL},
+	{0x0AE2, 0x0AE3, LB_CM},
+	{0x0AE6, 0x0AEF, LB_NU},
+	{0x0AF0, 0x0AF0, LB_AL},
+	{0x0AF1, 0x0AF1, LB_PR},
+	{0x0AF9, 0x0AF9, LB_AL},
+	{0x0AFA, 0x0AFF, LB_CM},
+	{0x0B01, 0x0B03, LB_CM},
+	{0x0B05, 0x0B0C, LB_AL},
+	{0x0B0F, 0x0B10, LB_AL},
+	{0x0B13, 0x0B28, LB_AL},
+	{0x0B2A, 0x0B30, LB_AL},
+	{0x0B32, 0x0B33, LB_AL},
+	{0x0B35, 0x0B39, LB_AL},
+	{0x0B3C, 0x0B3C, LB_CM},
+	{0x0B3D, 0x0B3D, LB_AL},
+	{0x0B3E, 0x0B44, LB_CM},
+	{0x0B47, 0x0B48, LB_CM},
+	{0x0B4B, 0x0B4D, LB_CM},
+	{0x0B55, 0x0B57, LB_CM},
+	{0x0B5C, 0x0B5D, LB_AL},
+	{0x0B5F, 0x0B61, LB_AL},
+	{0x0B62, 0x0B63, LB_CM},
+	{0x0B66, 0x0B6F, LB_NU},
+	{0x0B70, 0x0B77, LB_AL},
+	{0x0B82, 0x0B82, LB_CM},
+	{0x0B83, 0x0B83, LB_AL},
+	{0x0B85, 0x0B8A, LB_AL},
+	{0x0B8E, 0x0B90, LB_AL},
+	{0x0B92, 0x0B95, LB_AL},
+	{0x0B99, 0x0B9A, LB_AL},
+	{0x0B9C, 0x0B9C, LB_AL},
+	{0x0B9E, 0x0B9F, LB_AL},
+	{0x0BA3, 0x0BA4, LB_AL},
+	{0x0BA8, 0x0BAA, LB_AL},
+	{0x0BAE, 0x0BB9, LB_AL},
+	{0x0BBE, 0x0BC2, LB_CM},
+	{0x0BC6, 0x0BC8, LB_CM},
+	{0x0BCA, 0x0BCD, LB_CM},
+	{0x0BD0, 0x0BD0, LB_AL},
+	{0x0BD7, 0x0BD7, LB_CM},
+	{0x0BE6, 0x0BEF, LB_NU},
+	{0x0BF0, 0x0BF8, LB_AL},
+	{0x0BF9, 0x0BF9, LB_PR},
+	{0x0BFA, 0x0BFA, LB_AL},
+	{0x0C00, 0x0C04, LB_CM},
+	{0x0C05, 0x0C0C, LB_AL},
+	{0x0C0E, 0x0C10, LB_AL},
+	{0x0C12, 0x0C28, LB_AL},
+	{0x0C2A, 0x0C39, LB_AL},
+	{0x0C3C, 0x0C3C, LB_CM},
+	{0x0C3D, 0x0C3D, LB_AL},
+	{0x0C3E, 0x0C44, LB_CM},
+	{0x0C46, 0x0C48, LB_CM},
+	{0x0C4A, 0x0C4D, LB_CM},
+	{0x0C55, 0x0C56, LB_CM},
+	{0x0C58, 0x0C5A, LB_AL},
+	{0x0C5D, 0x0C5D, LB_AL},
+	{0x0C60, 0x0C61, LB_AL},
+	{0x0C62, 0x0C63, LB_CM},
+	{0x0C66, 0x0C6F, LB_NU},
+	{0x0C77, 0x0C77, LB_BB},
+	{0x0C78, 0x0C80, LB_AL},
+	{0x0C81, 0x0C83, LB_CM},
+	{0x0C84, 0x0C84, LB_BB},
+	{0x0C85, 0x0C8C, LB_AL},
+	{0x0C8E, 0x0C90, LB_AL},
+	{0x0C92, 0x0CA8, LB_AL},
+	{0x0CAA, 0x0CB3, LB_AL},
+	{0x0CB5, 0x0CB9, LB_AL},
+	{0x0CBC, 0x0CBC, LB_CM},
+	{0x0CBD, 0x0CBD, LB_AL},
+	{0x0CBE, 0x0CC4, LB_CM},
+	{0x0CC6, 0x0CC8, LB_CM},
+	{0x0CCA, 0x0CCD, LB_CM},
+	{0x0CD5, 0x0CD6, LB_CM},
+	{0x0CDD, 0x0CDE, LB_AL},
+	{0x0CE0, 0x0CE1, LB_AL},
+	{0x0CE2, 0x0CE3, LB_CM},
+	{0x0CE6, 0x0CEF, LB_NU},
+	{0x0CF1, 0x0CF2, LB_AL},
+	{0x0D00, 0x0D03, LB_CM},
+	{0x0D04, 0x0D0C, LB_AL},
+	{0x0D0E, 0x0D10, LB_AL},
+	{0x0D12, 0x0D3A, LB_AL},
+	{0x0D3B, 0x0D3C, LB_CM},
+	{0x0D3D, 0x0D3D, LB_AL},
+	{0x0D3E, 0x0D44, LB_CM},
+	{0x0D46, 0x0D48, LB_CM},
+	{0x0D4A, 0x0D4D, LB_CM},
+	{0x0D4E, 0x0D4F, LB_AL},
+	{0x0D54, 0x0D56, LB_AL},
+	{0x0D57, 0x0D57, LB_CM},
+	{0x0D58, 0x0D61, LB_AL},
+	{0x0D62, 0x0D63, LB_CM},
+	{0x0D66, 0x0D6F, LB_NU},
+	{0x0D70, 0x0D78, LB_AL},
+	{0x0D79, 0x0D79, LB_PO},
+	{0x0D7A, 0x0D7F, LB_AL},
+	{0x0D81, 0x0D83, LB_CM},
+	{0x0D85, 0x0D96, LB_AL},
+	{0x0D9A, 0x0DB1, LB_AL},
+	{0x0DB3, 0x0DBB, LB_AL},
+	{0x0DBD, 0x0DBD, LB_AL},
+	{0x0DC0, 0x0DC6, LB_AL},
+	{0x0DCA, 0x0DCA, LB_CM},
+	{0x0DCF, 0x0DD4, LB_CM},
+	{0x0DD6, 0x0DD6, LB_CM},
+	{0x0DD8, 0x0DDF, LB_CM},
+	{0x0DE6, 0x0DEF, LB_NU},
+	{0x0DF2, 0x0DF3, LB_CM},
+	{0x0DF4, 0x0DF4, LB_AL},
+	{0x0E01, 0x0E3A, LB_SA},
+	{0x0E3F, 0x0E3F, LB_PR},
+	{0x0E40, 0x0E4E, LB_SA},
+	{0x0E4F, 0x0E4F, LB_AL},
+	{0x0E50, 0x0E59, LB_NU},
+	{0x0E5A, 0x0E5B, LB_BA},
+	{0x0E81, 0x0E82, LB_SA},
+	{0x0E84, 0x0E84, LB_SA},
+	{0x0E86, 0x0E8A, LB_SA},
+	{0x0E8C, 0x0EA3, LB_SA},
+	{0x0EA5, 0x0EA5, LB_SA},
+	{0x0EA7, 0x0EBD, LB_SA},
+	{0x0EC0, 0x0EC4, LB_SA},
+	{0x0EC6, 0x0EC6, LB_SA},
+	{0x0EC8, 0x0ECD, LB_SA},
+	{0x0ED0, 0x0ED9, LB_NU},
+	{0x0EDC, 0x0EDF, LB_SA},
+	{0x0F00, 0x0F00, LB_AL},
+	{0x0F01, 0x0F04, LB_BB},
+	{0x0F05, 0x0F05, LB_AL},
+	{0x0F06, 0x0F07, LB_BB},
+	{0x0F08, 0x0F08, LB_GL},
+	{0x0F09, 0x0F0A, LB_BB},
+	{0x0F0B, 0x0F0B, LB_BA},
+	{0x0F0C, 0x0F0C, LB_GL},
+	{0x0F0D, 0x0F11, LB_EX},
+	{0x0F12, 0x0F12, LB_GL},
+	{0x0F13, 0x0F13, LB_AL},
+	{0x0F14, 0x0F14, LB_EX},
+	{0x0F15, 0x0F17, LB_AL},
+	{0x0F18, 0x0F19, LB_CM},
+	{0x0F1A, 0x0F1F, LB_AL},
+	{0x0F20, 0x0F29, LB_NU},
+	{0x0F2A, 0x0F33, LB_AL},
+	{0x0F34, 0x0F34, LB_BA},
+	{0x0F35, 0x0F35, LB_CM},
+	{0x0F36, 0x0F36, LB_AL},
+	{0x0F37, 0x0F37, LB_CM},
+	{0x0F38, 0x0F38, LB_AL},
+	{0x0F39, 0x0F39, LB_CM},
+	{0x0F3A, 0x0F3A, LB_OP},
+	{0x0F3B, 0x0F3B, LB_CL},
+	{0x0F3C, 0x0F3C, LB_OP},
+	{0x0F3D, 0x0F3D, LB_CL},
+	{0x0F3E, 0x0F3F, LB_CM},
+	{0x0F40, 0x0F47, LB_AL},
+	{0x0F49, 0x0F6C, LB_AL},
+	{0x0F71, 0x0F7E, LB_CM},
+	{0x0F7F, 0x0F7F, LB_BA},
+	{0x0F80, 0x0F84, LB_CM},
+	{0x0F85, 0x0F85, LB_BA},
+	{0x0F86, 0x0F87, LB_CM},
+	{0x0F88, 0x0F8C, LB_AL},
+	{0x0F8D, 0x0F97, LB_CM},
+	{0x0F99, 0x0FBC, LB_CM},
+	{0x0FBE, 0x0FBF, LB_BA},
+	{0x0FC0, 0x0FC5, LB_AL},
+	{0x0FC6, 0x0FC6, LB_CM},
+	{0x0FC7, 0x0FCC, LB_AL},
+	{0x0FCE, 0x0FCF, LB_AL},
+	{0x0FD0, 0x0FD1, LB_BB},
+	{0x0FD2, 0x0FD2, LB_BA},
+	{0x0FD3, 0x0FD3, LB_BB},
+	{0x0FD4, 0x0FD8, LB_AL},
+	{0x0FD9, 0x0FDA, LB_GL},
+	{0x1000, 0x103F, LB_SA},
+	{0x1040, 0x1049, LB_NU},
+	{0x104A, 0x104B, LB_BA},
+	{0x104C, 0x104F, LB_AL},
+	{0x1050, 0x108F, LB_SA},
+	{0x1090, 0x1099, LB_NU},
+	{0x109A, 0x109F, LB_SA},
+	{0x10A0, 0x10C5, LB_AL},
+	{0x10C7, 0x10C7, LB_AL},
+	{0x10CD, 0x10CD, LB_AL},
+	{0x10D0, 0x10FF, LB_AL},
+	{0x1100, 0x115F, LB_JL},
+	{0x1160, 0x11A7, LB_JV},
+	{0x11A8, 0x11FF, LB_JT},
+	{0x1200, 0x1248, LB_AL},
+	{0x124A, 0x124D, LB_AL},
+	{0x1250, 0x1256, LB_AL},
+	{0x1258, 0x1258, LB_AL},
+	{0x125A, 0x125D, LB_AL},
+	{0x1260, 0x1288, LB_AL},
+	{0x128A, 0x128D, LB_AL},
+	{0x1290, 0x12B0, LB_AL},
+	{0x12B2, 0x12B5, LB_AL},
+	{0x12B8, 0x12BE, LB_AL},
+	{0x12C0, 0x12C0, LB_AL},
+	{0x12C2, 0x12C5, LB_AL},
+	{0x12C8, 0x12D6, LB_AL},
+	{0x12D8, 0x1310, LB_AL},
+	{0x1312, 0x1315, LB_AL},
+	{0x1318, 0x135A, LB_AL},
+	{0x135D, 0x135F, LB_CM},
+	{0x1360, 0x1360, LB_AL},
+	{0x1361, 0x1361, LB_BA},
+	{0x1362, 0x137C, LB_AL},
+	{0x1380, 0x1399, LB_AL},
+	{0x13A0, 0x13F5, LB_AL},
+	{0x13F8, 0x13FD, LB_AL},
+	{0x1400, 0x1400, LB_BA},
+	{0x1401, 0x167F, LB_AL},
+	{0x1680, 0x1680, LB_BA},
+	{0x1681, 0x169A, LB_AL},
+	{0x169B, 0x169B, LB_OP},
+	{0x169C, 0x169C, LB_CL},
+	{0x16A0, 0x16EA, LB_AL},
+	{0x16EB, 0x16ED, LB_BA},
+	{0x16EE, 0x16F8, LB_AL},
+	{0x1700, 0x1711, LB_AL},
+	{0x1712, 0x1715, LB_CM},
+	{0x171F, 0x1731, LB_AL},
+	{0x1732, 0x1734, LB_CM},
+	{0x1735, 0x1736, LB_BA},
+	{0x1740, 0x1751, LB_AL},
+	{0x1752, 0x1753, LB_CM},
+	{0x1760, 0x176C, LB_AL},
+	{0x176E, 0x1770, LB_AL},
+	{0x1772, 0x1773, LB_CM},
+	{0x1780, 0x17D3, LB_SA},
+	{0x17D4, 0x17D5, LB_BA},
+	{0x17D6, 0x17D6, LB_NS},
+	{0x17D7, 0x17D7, LB_SA},
+	{0x17D8, 0x17D8, LB_BA},
+	{0x17D9, 0x17D9, LB_AL},
+	{0x17DA, 0x17DA, LB_BA},
+	{0x17DB, 0x17DB, LB_PR},
+	{0x17DC, 0x17DD, LB_SA},
+	{0x17E0, 0x17E9, LB_NU},
+	{0x17F0, 0x17F9, LB_AL},
+	{0x1800, 0x1801, LB_AL},
+	{0x1802, 0x1803, LB_EX},
+	{0x1804, 0x1805, LB_BA},
+	{0x1806, 0x1806, LB_BB},
+	{0x1807, 0x1807, LB_AL},
+	{0x1808, 0x1809, LB_EX},
+	{0x180A, 0x180A, LB_AL},
+	{0x180B, 0x180D, LB_CM},
+	{0x180E, 0x180E, LB_GL},
+	{0x180F, 0x180F, LB_CM},
+	{0x1810, 0x1819, LB_NU},
+	{0x1820, 0x1878, LB_AL},
+	{0x1880, 0x1884, LB_AL},
+	{0x1885, 0x1886, LB_CM},
+	{0x1887, 0x18A8, LB_AL},
+	{0x18A9, 0x18A9, LB_CM},
+	{0x18AA, 0x18AA, LB_AL},
+	{0x18B0, 0x18F5, LB_AL},
+	{0x1900, 0x191E, LB_AL},
+	{0x1920, 0x192B, LB_CM},
+	{0x1930, 0x193B, LB_CM},
+	{0x1940, 0x1940, LB_AL},
+	{0x1944, 0x1945, LB_EX},
+	{0x1946, 0x194F, LB_NU},
+	{0x1950, 0x196D, LB_SA},
+	{0x1970, 0x1974, LB_SA},
+	{0x1980, 0x19AB, LB_SA},
+	{0x19B0, 0x19C9, LB_SA},
+	{0x19D0, 0x19D9, LB_NU},
+	{0x19DA, 0x19DA, LB_SA},
+	{0x19DE, 0x19DF, LB_SA},
+	{0x19E0, 0x1A16, LB_AL},
+	{0x1A17, 0x1A1B, LB_CM},
+	{0x1A1E, 0x1A1F, LB_AL},
+	{0x1A20, 0x1A5E, LB_SA},
+	{0x1A60, 0x1A7C, LB_SA},
+	{0x1A7F, 0x1A7F, LB_CM},
+	{0x1A80, 0x1A89, LB_NU},
+	{0x1A90, 0x1A99, LB_NU},
+	{0x1AA0, 0x1AAD, LB_SA},
+	{0x1AB0, 0x1ACE, LB_CM},
+	{0x1B00, 0x1B04, LB_CM},
+	{0x1B05, 0x1B33, LB_AL},
+	{0x1B34, 0x1B44, LB_CM},
+	{0x1B45, 0x1B4C, LB_AL},
+	{0x1B50, 0x1B59, LB_NU},
+	{0x1B5A, 0x1B5B, LB_BA},
+	{0x1B5C, 0x1B5C, LB_AL},
+	{0x1B5D, 0x1B60, LB_BA},
+	{0x1B61, 0x1B6A, LB_AL},
+	{0x1B6B, 0x1B73, LB_CM},
+	{0x1B74, 0x1B7C, LB_AL},
+	{0x1B7D, 0x1B7E, LB_BA},
+	{0x1B80, 0x1B82, LB_CM},
+	{0x1B83, 0x1BA0, LB_AL},
+	{0x1BA1, 0x1BAD, LB_CM},
+	{0x1BAE, 0x1BAF, LB_AL},
+	{0x1BB0, 0x1BB9, LB_NU},
+	{0x1BBA, 0x1BE5, LB_AL},
+	{0x1BE6, 0x1BF3, LB_CM},
+	{0x1BFC, 0x1C23, LB_AL},
+	{0x1C24, 0x1C37, LB_CM},
+	{0x1C3B, 0x1C3F, LB_BA},
+	{0x1C40, 0x1C49, LB_NU},
+	{0x1C4D, 0x1C4F, LB_AL},
+	{0x1C50, 0x1C59, LB_NU},
+	{0x1C5A, 0x1C7D, LB_AL},
+	{0x1C7E, 0x1C7F, LB_BA},
+	{0x1C80, 0x1C88, LB_AL},
+	{0x1C90, 0x1CBA, LB_AL},
+	{0x1CBD, 0x1CC7, LB_AL},
+	{0x1CD0, 0x1CD2, LB_CM},
+	{0x1CD3, 0x1CD3, LB_AL},
+	{0x1CD4, 0x1CE8, LB_CM},
+	{0x1CE9, 0x1CEC, LB_AL},
+	{0x1CED, 0x1CED, LB_CM},
+	{0x1CEE, 0x1CF3, LB_AL},
+	{0x1CF4, 0x1CF4, LB_CM},
+	{0x1CF5, 0x1CF6, LB_AL},
+	{0x1CF7, 0x1CF9, LB_CM},
+	{0x1CFA, 0x1CFA, LB_AL},
+	{0x1D00, 0x1DBF, LB_AL},
+	{0x1DC0, 0x1DFF, LB_CM},
+	{0x1E00, 0x1F15, LB_AL},
+	{0x1F18, 0x1F1D, LB_AL},
+	{0x1F20, 0x1F45, LB_AL},
+	{0x1F48, 0x1F4D, LB_AL},
+	{0x1F50, 0x1F57, LB_AL},
+	{0x1F59, 0x1F59, LB_AL},
+	{0x1F5B, 0x1F5B, LB_AL},
+	{0x1F5D, 0x1F5D, LB_AL},
+	{0x1F5F, 0x1F7D, LB_AL},
+	{0x1F80, 0x1FB4, LB_AL},
+	{0x1FB6, 0x1FC4, LB_AL},
+	{0x1FC6, 0x1FD3, LB_AL},
+	{0x1FD6, 0x1FDB, LB_AL},
+	{0x1FDD, 0x1FEF, LB_AL},
+	{0x1FF2, 0x1FF4, LB_AL},
+	{0x1FF6, 0x1FFC, LB_AL},
+	{0x1FFD, 0x1FFD, LB_BB},
+	{0x1FFE, 0x1FFE, LB_AL},
+	{0x2000, 0x2006, LB_BA},
+	{0x2007, 0x2007, LB_GL},
+	{0x2008, 0x200A, LB_BA},
+	{0x200B, 0x200B, LB_ZW},
+	{0x200C, 0x200C, LB_CM},
+	{0x200D, 0x200D, LB_ZWJ},
+	{0x200E, 0x200F, LB_CM},
+	{0x2010, 0x2010, LB_BA},
+	{0x2011, 0x2011, LB_GL},
+	{0x2012, 0x2013, LB_BA},
+	{0x2014, 0x2014, LB_B2},
+	{0x2015, 0x2016, LB_AI},
+	{0x2017, 0x2017, LB_AL},
+	{0x2018, 0x2019, LB_QU},
+	{0x201A, 0x201A, LB_OP},
+	{0x201B, 0x201D, LB_QU},
+	{0x201E, 0x201E, LB_OP},
+	{0x201F, 0x201F, LB_QU},
+	{0x2020, 0x2021, LB_AI},
+	{0x2022, 0x2023, LB_AL},
+	{0x2024, 0x2026, LB_IN},
+	{0x2027, 0x2027, LB_BA},
+	{0x2028, 0x2029, LB_BK},
+	{0x202A, 0x202E, LB_CM},
+	{0x202F, 0x202F, LB_GL},
+	{0x2030, 0x2037, LB_PO},
+	{0x2038, 0x2038, LB_AL},
+	{0x2039, 0x203A, LB_QU},
+	{0x203B, 0x203B, LB_AI},
+	{0x203C, 0x203D, LB_NS},
+	{0x203E, 0x2043, LB_AL},
+	{0x2044, 0x2044, LB_IS},
+	{0x2045, 0x2045, LB_OP},
+	{0x2046, 0x2046, LB_CL},
+	{0x2047, 0x2049, LB_NS},
+	{0x204A, 0x2055, LB_AL},
+	{0x2056, 0x2056, LB_BA},
+	{0x2057, 0x2057, LB_AL},
+	{0x2058, 0x205B, LB_BA},
+	{0x205C, 0x205C, LB_AL},
+	{0x205D, 0x205F, LB_BA},
+	{0x2060, 0x2060, LB_WJ},
+	{0x2061, 0x2064, LB_AL},
+	{0x2066, 0x206F, LB_CM},
+	{0x2070, 0x2071, LB_AL},
+	{0x2074, 0x2074, LB_AI},
+	{0x2075, 0x207C, LB_AL},
+	{0x207D, 0x207D, LB_OP},
+	{0x207E, 0x207E, LB_CL},
+	{0x207F, 0x207F, LB_AI},
+	{0x2080, 0x2080, LB_AL},
+	{0x2081, 0x2084, LB_AI},
+	{0x2085, 0x208C, LB_AL},
+	{0x208D, 0x208D, LB_OP},
+	{0x208E, 0x208E, LB_CL},
+	{0x2090, 0x209C, LB_AL},
+	{0x20A0, 0x20A6, LB_PR},
+	{0x20A7, 0x20A7, LB_PO},
+	{0x20A8, 0x20B5, LB_PR},
+	{0x20B6, 0x20B6, LB_PO},
+	{0x20B7, 0x20BA, LB_PR},
+	{0x20BB, 0x20BB, LB_PO},
+	{0x20BC, 0x20BD, LB_PR},
+	{0x20BE, 0x20BE, LB_PO},
+	{0x20BF, 0x20BF, LB_PR},
+	{0x20C0, 0x20C0, LB_PO},
+	{0x20C1, 0x20CF, LB_PR},
+	{0x20D0, 0x20F0, LB_CM},
+	{0x2100, 0x2102, LB_AL},
+	{0x2103, 0x2103, LB_PO},
+	{0x2104, 0x2104, LB_AL},
+	{0x2105, 0x2105, LB_AI},
+	{0x2106, 0x2108, LB_AL},
+	{0x2109, 0x2109, LB_PO},
+	{0x210A, 0x2112, LB_AL},
+	{0x2113, 0x2113, LB_AI},
+	{0x2114, 0x2115, LB_AL},
+	{0x2116, 0x2116, LB_PR},
+	{0x2117, 0x2120, LB_AL},
+	{0x2121, 0x2122, LB_AI},
+	{0x2123, 0x212A, LB_AL},
+	{0x212B, 0x212B, LB_AI},
+	{0x212C, 0x2153, LB_AL},
+	{0x2154, 0x2155, LB_AI},
+	{0x2156, 0x215A, LB_AL},
+	{0x215B, 0x215B, LB_AI},
+	{0x215C, 0x215D, LB_AL},
+	{0x215E, 0x215E, LB_AI},
+	{0x215F, 0x215F, LB_AL},
+	{0x2160, 0x216B, LB_AI},
+	{0x216C, 0x216F, LB_AL},
+	{0x2170, 0x2179, LB_AI},
+	{0x217A, 0x2188, LB_AL},
+	{0x2189, 0x2189, LB_AI},
+	{0x218A, 0x218B, LB_AL},
+	{0x2190, 0x2199, LB_AI},
+	{0x219A, 0x21D1, LB_AL},
+	{0x21D2, 0x21D2, LB_AI},
+	{0x21D3, 0x21D3, LB_AL},
+	{0x21D4, 0x21D4, LB_AI},
+	{0x21D5, 0x21FF, LB_AL},
+	{0x2200, 0x2200, LB_AI},
+	{0x2201, 0x2201, LB_AL},
+	{0x2202, 0x2203, LB_AI},
+	{0x2204, 0x2206, LB_AL},
+	{0x2207, 0x2208, LB_AI},
+	{0x2209, 0x220A, LB_AL},
+	{0x220B, 0x220B, LB_AI},
+	{0x220C, 0x220E, LB_AL},
+	{0x220F, 0x220F, LB_AI},
+	{0x2210, 0x2210, LB_AL},
+	{0x2211, 0x2211, LB_AI},
+	{0x2212, 0x2213, LB_PR},
+	{0x2214, 0x2214, LB_AL},
+	{0x2215, 0x2215, LB_AI},
+	{0x2216, 0x2219, LB_AL},
+	{0x221A, 0x221A, LB_AI},
+	{0x221B, 0x221C, LB_AL},
+	{0x221D, 0x2220, LB_AI},
+	{0x2221, 0x2222, LB_AL},
+	{0x2223, 0x2223, LB_AI},
+	{0x2224, 0x2224, LB_AL},
+	{0x2225, 0x2225, LB_AI},
+	{0x2226, 0x2226, LB_AL},
+	{0x2227, 0x222C, LB_AI},
+	{0x222D, 0x222D, LB_AL},
+	{0x222E, 0x222E, LB_AI},
+	{0x222F, 0x2233, LB_AL},
+	{0x2234, 0x2237, LB_AI},
+	{0x2238, 0x223B, LB_AL},
+	{0x223C, 0x223D, LB_AI},
+	{0x223E, 0x2247, LB_AL},
+	{0x2248, 0x2248, LB_AI},
+	{0x2249, 0x224B, LB_AL},
+	{0x224C, 0x224C, LB_AI},
+	{0x224D, 0x2251, LB_AL},
+	{0x2252, 0x2252, LB_AI},
+	{0x2253, 0x225F, LB_AL},
+	{0x2260, 0x2261, LB_AI},
+	{0x2262, 0x2263, LB_AL},
+	{0x2264, 0x2267, LB_AI},
+	{0x2268, 0x2269, LB_AL},
+	{0x226A, 0x226B, LB_AI},
+	{0x226C, 0x226D, LB_AL},
+	{0x226E, 0x226F, LB_AI},
+	{0x2270, 0x2281, LB_AL},
+	{0x2282, 0x2283, LB_AI},
+	{0x2284, 0x2285, LB_AL},
+	{0x2286, 0x2287, LB_AI},
+	{0x2288, 0x2294, LB_AL},
+	{0x2295, 0x2295, LB_AI},
+	{0x2296, 0x2298, LB_AL},
+	{0x2299, 0x2299, LB_AI},
+	{0x229A, 0x22A4, LB_AL},
+	{0x22A5, 0x22A5, LB_AI},
+	{0x22A6, 0x22BE, LB_AL},
+	{0x22BF, 0x22BF, LB_AI},
+	{0x22C0, 0x22EE, LB_AL},
+	{0x22EF, 0x22EF, LB_IN},
+	{0x22F0, 0x2307, LB_AL},
+	{0x2308, 0x2308, LB_OP},
+	{0x2309, 0x2309, LB_CL},
+	{0x230A, 0x230A, LB_OP},
+	{0x230B, 0x230B, LB_CL},
+	{0x230C, 0x2311, LB_AL},
+	{0x2312, 0x2312, LB_AI},
+	{0x2313, 0x2319, LB_AL},
+	{0x231A, 0x231B, LB_ID},
+	{0x231C, 0x2328, LB_AL},
+	{0x2329, 0x2329, LB_OP},
+	{0x232A, 0x232A, LB_CL},
+	{0x232B, 0x23EF, LB_AL},
+	{0x23F0, 0x23F3, LB_ID},
+	{0x23F4, 0x2426, LB_AL},
+	{0x2440, 0x244A, LB_AL},
+	{0x2460, 0x24FE, LB_AI},
+	{0x24FF, 0x24FF, LB_AL},
+	{0x2500, 0x254B, LB_AI},
+	{0x254C, 0x254F, LB_AL},
+	{0x2550, 0x2574, LB_AI},
+	{0x2575, 0x257F, LB_AL},
+	{0x2580, 0x258F, LB_AI},
+	{0x2590, 0x2591, LB_AL},
+	{0x2592, 0x2595, LB_AI},
+	{0x2596, 0x259F, LB_AL},
+	{0x25A0, 0x25A1, LB_AI},
+	{0x25A2, 0x25A2, LB_AL},
+	{0x25A3, 0x25A9, LB_AI},
+	{0x25AA, 0x25B1, LB_AL},
+	{0x25B2, 0x25B3, LB_AI},
+	{0x25B4, 0x25B5, LB_AL},
+	{0x25B6, 0x25B7, LB_AI},
+	{0x25B8, 0x25BB, LB_AL},
+	{0x25BC, 0x25BD, LB_AI},
+	{0x25BE, 0x25BF, LB_AL},
+	{0x25C0, 0x25C1, LB_AI},
+	{0x25C2, 0x25C5, LB_AL},
+	{0x25C6, 0x25C8, LB_AI},
+	{0x25C9, 0x25CA, LB_AL},
+	{0x25CB, 0x25CB, LB_AI},
+	{0x25CC, 0x25CD, LB_AL},
+	{0x25CE, 0x25D1, LB_AI},
+	{0x25D2, 0x25E1, LB_AL},
+	{0x25E2, 0x25E5, LB_AI},
+	{0x25E6, 0x25EE, LB_AL},
+	{0x25EF, 0x25EF, LB_AI},
+	{0x25F0, 0x25FF, LB_AL},
+	{0x2600, 0x2603, LB_ID},
+	{0x2604, 0x2604, LB_AL},
+	{0x2605, 0x2606, LB_AI},
+	{0x2607, 0x2608, LB_AL},
+	{0x2609, 0x2609, LB_AI},
+	{0x260A, 0x260D, LB_AL},
+	{0x260E, 0x260F, LB_AI},
+	{0x2610, 0x2613, LB_AL},
+	{0x2614, 0x2615, LB_ID},
+	{0x2616, 0x2617, LB_AI},
+	{0x2618, 0x2618, LB_ID},
+	{0x2619, 0x2619, LB_AL},
+	{0x261A, 0x261C, LB_ID},
+	{0x261D, 0x261D, LB_EB},
+	{0x261E, 0x261F, LB_ID},
+	{0x2620, 0x2638, LB_AL},
+	{0x2639, 0x263B, LB_ID},
+	{0x263C, 0x263F, LB_AL},
+	{0x2640, 0x2640, LB_AI},
+	{0x2641, 0x2641, LB_AL},
+	{0x2642, 0x2642, LB_AI},
+	{0x2643, 0x265F, LB_AL},
+	{0x2660, 0x2661, LB_AI},
+	{0x2662, 0x2662, LB_AL},
+	{0x2663, 0x2665, LB_AI},
+	{0x2666, 0x2666, LB_AL},
+	{0x2667, 0x2667, LB_AI},
+	{0x2668, 0x2668, LB_ID},
+	{0x2669, 0x266A, LB_AI},
+	{0x266B, 0x266B, LB_AL},
+	{0x266C, 0x266D, LB_AI},
+	{0x266E, 0x266E, LB_AL},
+	{0x266F, 0x266F, LB_AI},
+	{0x2670, 0x267E, LB_AL},
+	{0x267F, 0x267F, LB_ID},
+	{0x2680, 0x269D, LB_AL},
+	{0x269E, 0x269F, LB_AI},
+	{0x26A0, 0x26BC, LB_AL},
+	{0x26BD, 0x26C8, LB_ID},
+	{0x26C9, 0x26CC, LB_AI},
+	{0x26CD, 0x26CD, LB_ID},
+	{0x26CE, 0x26CE, LB_AL},
+	{0x26CF, 0x26D1, LB_ID},
+	{0x26D2, 0x26D2, LB_AI},
+	{0x26D3, 0x26D4, LB_ID},
+	{0x26D5, 0x26D7, LB_AI},
+	{0x26D8, 0x26D9, LB_ID},
+	{0x26DA, 0x26DB, LB_AI},
+	{0x26DC, 0x26DC, LB_ID},
+	{0x26DD, 0x26DE, LB_AI},
+	{0x26DF, 0x26E1, LB_ID},
+	{0x26E2, 0x26E2, LB_AL},
+	{0x26E3, 0x26E3, LB_AI},
+	{0x26E4, 0x26E7, LB_AL},
+	{0x26E8, 0x26E9, LB_AI},
+	{0x26EA, 0x26EA, LB_ID},
+	{0x26EB, 0x26F0, LB_AI},
+	{0x26F1, 0x26F5, LB_ID},
+	{0x26F6, 0x26F6, LB_AI},
+	{0x26F7, 0x26F8, LB_ID},
+	{0x26F9, 0x26F9, LB_EB},
+	{0x26FA, 0x26FA, LB_ID},
+	{0x26FB, 0x26FC, LB_AI},
+	{0x26FD, 0x2704, LB_ID},
+	{0x2705, 0x2707, LB_AL},
+	{0x2708, 0x2709, LB_ID},
+	{0x270A, 0x270D, LB_EB},
+	{0x270E, 0x2756, LB_AL},
+	{0x2757, 0x2757, LB_AI},
+	{0x2758, 0x275A, LB_AL},
+	{0x275B, 0x2760, LB_QU},
+	{0x2761, 0x2761, LB_AL},
+	{0x2762, 0x2763, LB_EX},
+	{0x2764, 0x2764, LB_ID},
+	{0x2765, 0x2767, LB_AL},
+	{0x2768, 0x2768, LB_OP},
+	{0x2769, 0x2769, LB_CL},
+	{0x276A, 0x276A, LB_OP},
+	{0x276B, 0x276B, LB_CL},
+	{0x276C, 0x276C, LB_OP},
+	{0x276D, 0x276D, LB_CL},
+	{0x276E, 0x276E, LB_OP},
+	{0x276F, 0x276F, LB_CL},
+	{0x2770, 0x2770, LB_OP},
+	{0x2771, 0x2771, LB_CL},
+	{0x2772, 0x2772, LB_OP},
+	{0x2773, 0x2773, LB_CL},
+	{0x2774, 0x2774, LB_OP},
+	{0x2775, 0x2775, LB_CL},
+	{0x2776, 0x2793, LB_AI},
+	{0x2794, 0x27C4, LB_AL},
+	{0x27C5, 0x27C5, LB_OP},
+	{0x27C6, 0x27C6, LB_CL},
+	{0x27C7, 0x27E5, LB_AL},
+	{0x27E6, 0x27E6, LB_OP},
+	{0x27E7, 0x27E7, LB_CL},
+	{0x27E8, 0x27E8, LB_OP},
+	{0x27E9, 0x27E9, LB_CL},
+	{0x27EA, 0x27EA, LB_OP},
+	{0x27EB, 0x27EB, LB_CL},
+	{0x27EC, 0x27EC, LB_OP},
+	{0x27ED, 0x27ED, LB_CL},
+	{0x27EE, 0x27EE, LB_OP},
+	{0x27EF, 0x27EF, LB_CL},
+	{0x27F0, 0x2982, LB_AL},
+	{0x2983, 0x2983, LB_OP},
+	{0x2984, 0x2984, LB_CL},
+	{0x2985, 0x2985, LB_OP},
+	{0x2986, 0x2986, LB_CL},
+	{0x2987, 0x2987, LB_OP},
+	{0x2988, 0x2988, LB_CL},
+	{0x2989, 0x2989, LB_OP},
+	{0x298A, 0x298A, LB_CL},
+	{0x298B, 0x298B, LB_OP},
+	{0x298C, 0x298C, LB_CL},
+	{0x298D, 0x298D, LB_OP},
+	{0x298E, 0x298E, LB_CL},
+	{0x298F, 0x298F, LB_OP},
+	{0x2990, 0x2990, LB_CL},
+	{0x2991, 0x2991, LB_OP},
+	{0x2992, 0x2992, LB_CL},
+	{0x2993, 0x2993, LB_OP},
+	{0x2994, 0x2994, LB_CL},
+	{0x2995, 0x2995, LB_OP},
+	{0x2996, 0x2996, LB_CL},
+	{0x2997, 0x2997, LB_OP},
+	{0x2998, 0x2998, LB_CL},
+	{0x2999, 0x29D7, LB_AL},
+	{0x29D8, 0x29D8, LB_OP},
+	{0x29D9, 0x29D9, LB_CL},
+	{0x29DA, 0x29DA, LB_OP},
+	{0x29DB, 0x29DB, LB_CL},
+	{0x29DC, 0x29FB, LB_AL},
+	{0x29FC, 0x29FC, LB_OP},
+	{0x29FD, 0x29FD, LB_CL},
+	{0x29FE, 0x2B54, LB_AL},
+	{0x2B55, 0x2B59, LB_AI},
+	{0x2B5A, 0x2B73, LB_AL},
+	{0x2B76, 0x2B95, LB_AL},
+	{0x2B97, 0x2CEE, LB_AL},
+	{0x2CEF, 0x2CF1, LB_CM},
+	{0x2CF2, 0x2CF3, LB_AL},
+	{0x2CF9, 0x2CF9, LB_EX},
+	{0x2CFA, 0x2CFC, LB_BA},
+	{0x2CFD, 0x2CFD, LB_AL},
+	{0x2CFE, 0x2CFE, LB_EX},
+	{0x2CFF, 0x2CFF, LB_BA},
+	{0x2D00, 0x2D25, LB_AL},
+	{0x2D27, 0x2D27, LB_AL},
+	{0x2D2D, 0x2D2D, LB_AL},
+	{0x2D30, 0x2D67, LB_AL},
+	{0x2D6F, 0x2D6F, LB_AL},
+	{0x2D70, 0x2D70, LB_BA},
+	{0x2D7F, 0x2D7F, LB_CM},
+	{0x2D80, 0x2D96, LB_AL},
+	{0x2DA0, 0x2DA6, LB_AL},
+	{0x2DA8, 0x2DAE, LB_AL},
+	{0x2DB0, 0x2DB6, LB_AL},
+	{0x2DB8, 0x2DBE, LB_AL},
+	{0x2DC0, 0x2DC6, LB_AL},
+	{0x2DC8, 0x2DCE, LB_AL},
+	{0x2DD0, 0x2DD6, LB_AL},
+	{0x2DD8, 0x2DDE, LB_AL},
+	{0x2DE0, 0x2DFF, LB_CM},
+	{0x2E00, 0x2E0D, LB_QU},
+	{0x2E0E, 0x2E15, LB_BA},
+	{0x2E16, 0x2E16, LB_AL},
+	{0x2E17, 0x2E17, LB_BA},
+	{0x2E18, 0x2E18, LB_OP},
+	{0x2E19, 0x2E19, LB_BA},
+	{0x2E1A, 0x2E1B, LB_AL},
+	{0x2E1C, 0x2E1D, LB_QU},
+	{0x2E1E, 0x2E1F, LB_AL},
+	{0x2E20, 0x2E21, LB_QU},
+	{0x2E22, 0x2E22, LB_OP},
+	{0x2E23, 0x2E23, LB_CL},
+	{0x2E24, 0x2E24, LB_OP},
+	{0x2E25, 0x2E25, LB_CL},
+	{0x2E26, 0x2E26, LB_OP},
+	{0x2E27, 0x2E27, LB_CL},
+	{0x2E28, 0x2E28, LB_OP},
+	{0x2E29, 0x2E29, LB_CL},
+	{0x2E2A, 0x2E2D, LB_BA},
+	{0x2E2E, 0x2E2E, LB_EX},
+	{0x2E2F, 0x2E2F, LB_AL},
+	{0x2E30, 0x2E31, LB_BA},
+	{0x2E32, 0x2E32, LB_AL},
+	{0x2E33, 0x2E34, LB_BA},
+	{0x2E35, 0x2E39, LB_AL},
+	{0x2E3A, 0x2E3B, LB_B2},
+	{0x2E3C, 0x2E3E, LB_BA},
+	{0x2E3F, 0x2E3F, LB_AL},
+	{0x2E40, 0x2E41, LB_BA},
+	{0x2E42, 0x2E42, LB_OP},
+	{0x2E43, 0x2E4A, LB_BA},
+	{0x2E4B, 0x2E4B, LB_AL},
+	{0x2E4C, 0x2E4C, LB_BA},
+	{0x2E4D, 0x2E4D, LB_AL},
+	{0x2E4E, 0x2E4F, LB_BA},
+	{0x2E50, 0x2E52, LB_AL},
+	{0x2E53, 0x2E54, LB_EX},
+	{0x2E55, 0x2E55, LB_OP},
+	{0x2E56, 0x2E56, LB_CL},
+	{0x2E57, 0x2E57, LB_OP},
+	{0x2E58, 0x2E58, LB_CL},
+	{0x2E59, 0x2E59, LB_OP},
+	{0x2E5A, 0x2E5A, LB_CL},
+	{0x2E5B, 0x2E5B, LB_OP},
+	{0x2E5C, 0x2E5C, LB_CL},
+	{0x2E5D, 0x2E5D, LB_BA},
+	{0x2E80, 0x2E99, LB_ID},
+	{0x2E9B, 0x2EF3, LB_ID},
+	{0x2F00, 0x2FD5, LB_ID},
+	{0x2FF0, 0x2FFB, LB_ID},
+	{0x3000, 0x3000, LB_BA},
+	{0x3001, 0x3002, LB_CL},
+	{0x3003, 0x3004, LB_ID},
+	{0x3005, 0x3005, LB_NS},
+	{0x3006, 0x3007, LB_ID},
+	{0x3008, 0x3008, LB_OP},
+	{0x3009, 0x3009, LB_CL},
+	{0x300A, 0x300A, LB_OP},
+	{0x300B, 0x300B, LB_CL},
+	{0x300C, 0x300C, LB_OP},
+	{0x300D, 0x300D, LB_CL},
+	{0x300E, 0x300E, LB_OP},
+	{0x300F, 0x300F, LB_CL},
+	{0x3010, 0x3010, LB_OP},
+	{0x3011, 0x3011, LB_CL},
+	{0x3012, 0x3013, LB_ID},
+	{0x3014, 0x3014, LB_OP},
+	{0x3015, 0x3015, LB_CL},
+	{0x3016, 0x3016, LB_OP},
+	{0x3017, 0x3017, LB_CL},
+	{0x3018, 0x3018, LB_OP},
+	{0x3019, 0x3019, LB_CL},
+	{0x301A, 0x301A, LB_OP},
+	{0x301B, 0x301B, LB_CL},
+	{0x301C, 0x301C, LB_NS},
+	{0x301D, 0x301D, LB_OP},
+	{0x301E, 0x301F, LB_CL},
+	{0x3020, 0x3029, LB_ID},
+	{0x302A, 0x302F, LB_CM},
+	{0x3030, 0x3034, LB_ID},
+	{0x3035, 0x3035, LB_CM},
+	{0x3036, 0x303A, LB_ID},
+	{0x303B, 0x303C, LB_NS},
+	{0x303D, 0x303F, LB_ID},
+	{0x3041, 0x3041, LB_CJ},
+	{0x3042, 0x3042, LB_ID},
+	{0x3043, 0x3043, LB_CJ},
+	{0x3044, 0x3044, LB_ID},
+	{0x3045, 0x3045, LB_CJ},
+	{0x3046, 0x3046, LB_ID},
+	{0x3047, 0x3047, LB_CJ},
+	{0x3048, 0x3048, LB_ID},
+	{0x3049, 0x3049, LB_CJ},
+	{0x304A, 0x3062, LB_ID},
+	{0x3063, 0x3063, LB_CJ},
+	{0x3064, 0x3082, LB_ID},
+	{0x3083, 0x3083, LB_CJ},
+	{0x3084, 0x3084, LB_ID},
+	{0x3085, 0x3085, LB_CJ},
+	{0x3086, 0x3086, LB_ID},
+	{0x3087, 0x3087, LB_CJ},
+	{0x3088, 0x308D, LB_ID},
+	{0x308E, 0x308E, LB_CJ},
+	{0x308F, 0x3094, LB_ID},
+	{0x3095, 0x3096, LB_CJ},
+	{0x3099, 0x309A, LB_CM},
+	{0x309B, 0x309E, LB_NS},
+	{0x309F, 0x309F, LB_ID},
+	{0x30A0, 0x30A0, LB_NS},
+	{0x30A1, 0x30A1, LB_CJ},
+	{0x30A2, 0x30A2, LB_ID},
+	{0x30A3, 0x30A3, LB_CJ},
+	{0x30A4, 0x30A4, LB_ID},
+	{0x30A5, 0x30A5, LB_CJ},
+	{0x30A6, 0x30A6, LB_ID},
+	{0x30A7, 0x30A7, LB_CJ},
+	{0x30A8, 0x30A8, LB_ID},
+	{0x30A9, 0x30A9, LB_CJ},
+	{0x30AA, 0x30C2, LB_ID},
+	{0x30C3, 0x30C3, LB_CJ},
+	{0x30C4, 0x30E2, LB_ID},
+	{0x30E3, 0x30E3, LB_CJ},
+	{0x30E4, 0x30E4, LB_ID},
+	{0x30E5, 0x30E5, LB_CJ},
+	{0x30E6, 0x30E6, LB_ID},
+	{0x30E7, 0x30E7, LB_CJ},
+	{0x30E8, 0x30ED, LB_ID},
+	{0x30EE, 0x30EE, LB_CJ},
+	{0x30EF, 0x30F4, LB_ID},
+	{0x30F5, 0x30F6, LB_CJ},
+	{0x30F7, 0x30FA, LB_ID},
+	{0x30FB, 0x30FB, LB_NS},
+	{0x30FC, 0x30FC, LB_CJ},
+	{0x30FD, 0x30FE, LB_NS},
+	{0x30FF, 0x30FF, LB_ID},
+	{0x3105, 0x312F, LB_ID},
+	{0x3131, 0x318E, LB_ID},
+	{0x3190, 0x31E3, LB_ID},
+	{0x31F0, 0x31FF, LB_CJ},
+	{0x3200, 0x321E, LB_ID},
+	{0x3220, 0x3247, LB_ID},
+	{0x3248, 0x324F, LB_AI},
+	{0x3250, 0x4DBF, LB_ID},
+	{0x4DC0, 0x4DFF, LB_AL},
+	{0x4E00, 0xA014, LB_ID},
+	{0xA015, 0xA015, LB_NS},
+	{0xA016, 0xA48C, LB_ID},
+	{0xA490, 0xA4C6, LB_ID},
+	{0xA4D0, 0xA4FD, LB_AL},
+	{0xA4FE, 0xA4FF, LB_BA},
+	{0xA500, 0xA60C, LB_AL},
+	{0xA60D, 0xA60D, LB_BA},
+	{0xA60E, 0xA60E, LB_EX},
+	{0xA60F, 0xA60F, LB_BA},
+	{0xA610, 0xA61F, LB_AL},
+	{0xA620, 0xA629, LB_NU},
+	{0xA62A, 0xA62B, LB_AL},
+	{0xA640, 0xA66E, LB_AL},
+	{0xA66F, 0xA672, LB_CM},
+	{0xA673, 0xA673, LB_AL},
+	{0xA674, 0xA67D, LB_CM},
+	{0xA67E, 0xA69D, LB_AL},
+	{0xA69E, 0xA69F, LB_CM},
+	{0xA6A0, 0xA6EF, LB_AL},
+	{0xA6F0, 0xA6F1, LB_CM},
+	{0xA6F2, 0xA6F2, LB_AL},
+	{0xA6F3, 0xA6F7, LB_BA},
+	{0xA700, 0xA7CA, LB_AL},
+	{0xA7D0, 0xA7D1, LB_AL},
+	{0xA7D3, 0xA7D3, LB_AL},
+	{0xA7D5, 0xA7D9, LB_AL},
+	{0xA7F2, 0xA801, LB_AL},
+	{0xA802, 0xA802, LB_CM},
+	{0xA803, 0xA805, LB_AL},
+	{0xA806, 0xA806, LB_CM},
+	{0xA807, 0xA80A, LB_AL},
+	{0xA80B, 0xA80B, LB_CM},
+	{0xA80C, 0xA822, LB_AL},
+	{0xA823, 0xA827, LB_CM},
+	{0xA828, 0xA82B, LB_AL},
+	{0xA82C, 0xA82C, LB_CM},
+	{0xA830, 0xA837, LB_AL},
+	{0xA838, 0xA838, LB_PO},
+	{0xA839, 0xA839, LB_AL},
+	{0xA840, 0xA873, LB_AL},
+	{0xA874, 0xA875, LB_BB},
+	{0xA876, 0xA877, LB_EX},
+	{0xA880, 0xA881, LB_CM},
+	{0xA882, 0xA8B3, LB_AL},
+	{0xA8B4, 0xA8C5, LB_CM},
+	{0xA8CE, 0xA8CF, LB_BA},
+	{0xA8D0, 0xA8D9, LB_NU},
+	{0xA8E0, 0xA8F1, LB_CM},
+	{0xA8F2, 0xA8FB, LB_AL},
+	{0xA8FC, 0xA8FC, LB_BB},
+	{0xA8FD, 0xA8FE, LB_AL},
+	{0xA8FF, 0xA8FF, LB_CM},
+	{0xA900, 0xA909, LB_NU},
+	{0xA90A, 0xA925, LB_AL},
+	{0xA926, 0xA92D, LB_CM},
+	{0xA92E, 0xA92F, LB_BA},
+	{0xA930, 0xA946, LB_AL},
+	{0xA947, 0xA953, LB_CM},
+	{0xA95F, 0xA95F, LB_AL},
+	{0xA960, 0xA97C, LB_JL},
+	{0xA980, 0xA983, LB_CM},
+	{0xA984, 0xA9B2, LB_AL},
+	{0xA9B3, 0xA9C0, LB_CM},
+	{0xA9C1, 0xA9C6, LB_AL},
+	{0xA9C7, 0xA9C9, LB_BA},
+	{0xA9CA, 0xA9CD, LB_AL},
+	{0xA9CF, 0xA9CF, LB_AL},
+	{0xA9D0, 0xA9D9, LB_NU},
+	{0xA9DE, 0xA9DF, LB_AL},
+	{0xA9E0, 0xA9EF, LB_SA},
+	{0xA9F0, 0xA9F9, LB_NU},
+	{0xA9FA, 0xA9FE, LB_SA},
+	{0xAA00, 0xAA28, LB_AL},
+	{0xAA29, 0xAA36, LB_CM},
+	{0xAA40, 0xAA42, LB_AL},
+	{0xAA43, 0xAA43, LB_CM},
+	{0xAA44, 0xAA4B, LB_AL},
+	{0xAA4C, 0xAA4D, LB_CM},
+	{0xAA50, 0xAA59, LB_NU},
+	{0xAA5C, 0xAA5C, LB_AL},
+	{0xAA5D, 0xAA5F, LB_BA},
+	{0xAA60, 0xAAC2, LB_SA},
+	{0xAADB, 0xAADF, LB_SA},
+	{0xAAE0, 0xAAEA, LB_AL},
+	{0xAAEB, 0xAAEF, LB_CM},
+	{0xAAF0, 0xAAF1, LB_BA},
+	{0xAAF2, 0xAAF4, LB_AL},
+	{0xAAF5, 0xAAF6, LB_CM},
+	{0xAB01, 0xAB06, LB_AL},
+	{0xAB09, 0xAB0E, LB_AL},
+	{0xAB11, 0xAB16, LB_AL},
+	{0xAB20, 0xAB26, LB_AL},
+	{0xAB28, 0xAB2E, LB_AL},
+	{0xAB30, 0xAB6B, LB_AL},
+	{0xAB70, 0xABE2, LB_AL},
+	{0xABE3, 0xABEA, LB_CM},
+	{0xABEB, 0xABEB, LB_BA},
+	{0xABEC, 0xABED, LB_CM},
+	{0xABF0, 0xABF9, LB_NU},
+	{0xAC00, 0xAC00, LB_H2},
+	{0xAC01, 0xAC1B, LB_H3},
+	{0xAC1C, 0xAC1C, LB_H2},
+	{0xAC1D, 0xAC37, LB_H3},
+	{0xAC38, 0xAC38, LB_H2},
+	{0xAC39, 0xAC53, LB_H3},
+	{0xAC54, 0xAC54, LB_H2},
+	{0xAC55, 0xAC6F, LB_H3},
+	{0xAC70, 0xAC70, LB_H2},
+	{0xAC71, 0xAC8B, LB_H3},
+	{0xAC8C, 0xAC8C, LB_H2},
+	{0xAC8D, 0xACA7, LB_H3},
+	{0xACA8, 0xACA8, LB_H2},
+	{0xACA9, 0xACC3, LB_H3},
+	{0xACC4, 0xACC4, LB_H2},
+	{0xACC5, 0xACDF, LB_H3},
+	{0xACE0, 0xACE0, LB_H2},
+	{0xACE1, 0xACFB, LB_H3},
+	{0xACFC, 0xACFC, LB_H2},
+	{0xACFD, 0xAD17, LB_H3},
+	{0xAD18, 0xAD18, LB_H2},
+	{0xAD19, 0xAD33, LB_H3},
+	{0xAD34, 0xAD34, LB_H2},
+	{0xAD35, 0xAD4F, LB_H3},
+	{0xAD50, 0xAD50, LB_H2},
+	{0xAD51, 0xAD6B, LB_H3},
+	{0xAD6C, 0xAD6C, LB_H2},
+	{0xAD6D, 0xAD87, LB_H3},
+	{0xAD88, 0xAD88, LB_H2},
+	{0xAD89, 0xADA3, LB_H3},
+	{0xADA4, 0xADA4, LB_H2},
+	{0xADA5, 0xADBF, LB_H3},
+	{0xADC0, 0xADC0, LB_H2},
+	{0xADC1, 0xADDB, LB_H3},
+	{0xADDC, 0xADDC, LB_H2},
+	{0xADDD, 0xADF7, LB_H3},
+	{0xADF8, 0xADF8, LB_H2},
+	{0xADF9, 0xAE13, LB_H3},
+	{0xAE14, 0xAE14, LB_H2},
+	{0xAE15, 0xAE2F, LB_H3},
+	{0xAE30, 0xAE30, LB_H2},
+	{0xAE31, 0xAE4B, LB_H3},
+	{0xAE4C, 0xAE4C, LB_H2},
+	{0xAE4D, 0xAE67, LB_H3},
+	{0xAE68, 0xAE68, LB_H2},
+	{0xAE69, 0xAE83, LB_H3},
+	{0xAE84, 0xAE84, LB_H2},
+	{0xAE85, 0xAE9F, LB_H3},
+	{0xAEA0, 0xAEA0, LB_H2},
+	{0xAEA1, 0xAEBB, LB_H3},
+	{0xAEBC, 0xAEBC, LB_H2},
+	{0xAEBD, 0xAED7, LB_H3},
+	{0xAED8, 0xAED8, LB_H2},
+	{0xAED9, 0xAEF3, LB_H3},
+	{0xAEF4, 0xAEF4, LB_H2},
+	{0xAEF5, 0xAF0F, LB_H3},
+	{0xAF10, 0xAF10, LB_H2},
+	{0xAF11, 0xAF2B, LB_H3},
+	{0xAF2C, 0xAF2C, LB_H2},
+	{0xAF2D, 0xAF47, LB_H3},
+	{0xAF48, 0xAF48, LB_H2},
+	{0xAF49, 0xAF63, LB_H3},
+	{0xAF64, 0xAF64, LB_H2},
+	{0xAF65, 0xAF7F, LB_H3},
+	{0xAF80, 0xAF80, LB_H2},
+	{0xAF81, 0xAF9B, LB_H3},
+	{0xAF9C, 0xAF9C, LB_H2},
+	{0xAF9D, 0xAFB7, LB_H3},
+	{0xAFB8, 0xAFB8, LB_H2},
+	{0xAFB9, 0xAFD3, LB_H3},
+	{0xAFD4, 0xAFD4, LB_H2},
+	{0xAFD5, 0xAFEF, LB_H3},
+	{0xAFF0, 0xAFF0, LB_H2},
+	{0xAFF1, 0xB00B, LB_H3},
+	{0xB00C, 0xB00C, LB_H2},
+	{0xB00D, 0xB027, LB_H3},
+	{0xB028, 0xB028, LB_H2},
+	{0xB029, 0xB043, LB_H3},
+	{0xB044, 0xB044, LB_H2},
+	{0xB045, 0xB05F, LB_H3},
+	{0xB060, 0xB060, LB_H2},
+	{0xB061, 0xB07B, LB_H3},
+	{0xB07C, 0xB07C, LB_H2},
+	{0xB07D, 0xB097, LB_H3},
+	{0xB098, 0xB098, LB_H2},
+	{0xB099, 0xB0B3, LB_H3},
+	{0xB0B4, 0xB0B4, LB_H2},
+	{0xB0B5, 0xB0CF, LB_H3},
+	{0xB0D0, 0xB0D0, LB_H2},
+	{0xB0D1, 0xB0EB, LB_H3},
+	{0xB0EC, 0xB0EC, LB_H2},
+	{0xB0ED, 0xB107, LB_H3},
+	{0xB108, 0xB108, LB_H2},
+	{0xB109, 0xB123, LB_H3},
+	{0xB124, 0xB124, LB_H2},
+	{0xB125, 0xB13F, LB_H3},
+	{0xB140, 0xB140, LB_H2},
+	{0xB141, 0xB15B, LB_H3},
+	{0xB15C, 0xB15C, LB_H2},
+	{0xB15D, 0xB177, LB_H3},
+	{0xB178, 0xB178, LB_H2},
+	{0xB179, 0xB193, LB_H3},
+	{0xB194, 0xB194, LB_H2},
+	{0xB195, 0xB1AF, LB_H3},
+	{0xB1B0, 0xB1B0, LB_H2},
+	{0xB1B1, 0xB1CB, LB_H3},
+	{0xB1CC, 0xB1CC, LB_H2},
+	{0xB1CD, 0xB1E7, LB_H3},
+	{0xB1E8, 0xB1E8, LB_H2},
+	{0xB1E9, 0xB203, LB_H3},
+	{0xB204, 0xB204, LB_H2},
+	{0xB205, 0xB21F, LB_H3},
+	{0xB220, 0xB220, LB_H2},
+	{0xB221, 0xB23B, LB_H3},
+	{0xB23C, 0xB23C, LB_H2},
+	{0xB23D, 0xB257, LB_H3},
+	{0xB258, 0xB258, LB_H2},
+	{0xB259, 0xB273, LB_H3},
+	{0xB274, 0xB274, LB_H2},
+	{0xB275, 0xB28F, LB_H3},
+	{0xB290, 0xB290, LB_H2},
+	{0xB291, 0xB2AB, LB_H3},
+	{0xB2AC, 0xB2AC, LB_H2},
+	{0xB2AD, 0xB2C7, LB_H3},
+	{0xB2C8, 0xB2C8, LB_H2},
+	{0xB2C9, 0xB2E3, LB_H3},
+	{0xB2E4, 0xB2E4, LB_H2},
+	{0xB2E5, 0xB2FF, LB_H3},
+	{0xB300, 0xB300, LB_H2},
+	{0xB301, 0xB31B, LB_H3},
+	{0xB31C, 0xB31C, LB_H2},
+	{0xB31D, 0xB337, LB_H3},
+	{0xB338, 0xB338, LB_H2},
+	{0xB339, 0xB353, LB_H3},
+	{0xB354, 0xB354, LB_H2},
+	{0xB355, 0xB36F, LB_H3},
+	{0xB370, 0xB370, LB_H2},
+	{0xB371, 0xB38B, LB_H3},
+	{0xB38C, 0xB38C, LB_H2},
+	{0xB38D, 0xB3A7, LB_H3},
+	{0xB3A8, 0xB3A8, LB_H2},
+	{0xB3A9, 0xB3C3, LB_H3},
+	{0xB3C4, 0xB3C4, LB_H2},
+	{0xB3C5, 0xB3DF, LB_H3},
+	{0xB3E0, 0xB3E0, LB_H2},
+	{0xB3E1, 0xB3FB, LB_H3},
+	{0xB3FC, 0xB3FC, LB_H2},
+	{0xB3FD, 0xB417, LB_H3},
+	{0xB418, 0xB418, LB_H2},
+	{0xB419, 0xB433, LB_H3},
+	{0xB434, 0xB434, LB_H2},
+	{0xB435, 0xB44F, LB_H3},
+	{0xB450, 0xB450, LB_H2},
+	{0xB451, 0xB46B, LB_H3},
+	{0xB46C, 0xB46C, LB_H2},
+	{0xB46D, 0xB487, LB_H3},
+	{0xB488, 0xB488, LB_H2},
+	{0xB489, 0xB4A3, LB_H3},
+	{0xB4A4, 0xB4A4, LB_H2},
+	{0xB4A5, 0xB4BF, LB_H3},
+	{0xB4C0, 0xB4C0, LB_H2},
+	{0xB4C1, 0xB4DB, LB_H3},
+	{0xB4DC, 0xB4DC, LB_H2},
+	{0xB4DD, 0xB4F7, LB_H3},
+	{0xB4F8, 0xB4F8, LB_H2},
+	{0xB4F9, 0xB513, LB_H3},
+	{0xB514, 0xB514, LB_H2},
+	{0xB515, 0xB52F, LB_H3},
+	{0xB530, 0xB530, LB_H2},
+	{0xB531, 0xB54B, LB_H3},
+	{0xB54C, 0xB54C, LB_H2},
+	{0xB54D, 0xB567, LB_H3},
+	{0xB568, 0xB568, LB_H2},
+	{0xB569, 0xB583, LB_H3},
+	{0xB584, 0xB584, LB_H2},
+	{0xB585, 0xB59F, LB_H3},
+	{0xB5A0, 0xB5A0, LB_H2},
+	{0xB5A1, 0xB5BB, LB_H3},
+	{0xB5BC, 0xB5BC, LB_H2},
+	{0xB5BD, 0xB5D7, LB_H3},
+	{0xB5D8, 0xB5D8, LB_H2},
+	{0xB5D9, 0xB5F3, LB_H3},
+	{0xB5F4, 0xB5F4, LB_H2},
+	{0xB5F5, 0xB60F, LB_H3},
+	{0xB610, 0xB610, LB_H2},
+	{0xB611, 0xB62B, LB_H3},
+	{0xB62C, 0xB62C, LB_H2},
+	{0xB62D, 0xB647, LB_H3},
+	{0xB648, 0xB648, LB_H2},
+	{0xB649, 0xB663, LB_H3},
+	{0xB664, 0xB664, LB_H2},
+	{0xB665, 0xB67F, LB_H3},
+	{0xB680, 0xB680, LB_H2},
+	{0xB681, 0xB69B, LB_H3},
+	{0xB69C, 0xB69C, LB_H2},
+	{0xB69D, 0xB6B7, LB_H3},
+	{0xB6B8, 0xB6B8, LB_H2},
+	{0xB6B9, 0xB6D3, LB_H3},
+	{0xB6D4, 0xB6D4, LB_H2},
+	{0xB6D5, 0xB6EF, LB_H3},
+	{0xB6F0, 0xB6F0, LB_H2},
+	{0xB6F1, 0xB70B, LB_H3},
+	{0xB70C, 0xB70C, LB_H2},
+	{0xB70D, 0xB727, LB_H3},
+	{0xB728, 0xB728, LB_H2},
+	{0xB729, 0xB743, LB_H3},
+	{0xB744, 0xB744, LB_H2},
+	{0xB745, 0xB75F, LB_H3},
+	{0xB760, 0xB760, LB_H2},
+	{0xB761, 0xB77B, LB_H3},
+	{0xB77C, 0xB77C, LB_H2},
+	{0xB77D, 0xB797, LB_H3},
+	{0xB798, 0xB798, LB_H2},
+	{0xB799, 0xB7B3, LB_H3},
+	{0xB7B4, 0xB7B4, LB_H2},
+	{0xB7B5, 0xB7CF, LB_H3},
+	{0xB7D0, 0xB7D0, LB_H2},
+	{0xB7D1, 0xB7EB, LB_H3},
+	{0xB7EC, 0xB7EC, LB_H2},
+	{0xB7ED, 0xB807, LB_H3},
+	{0xB808, 0xB808, LB_H2},
+	{0xB809, 0xB823, LB_H3},
+	{0xB824, 0xB824, LB_H2},
+	{0xB825, 0xB83F, LB_H3},
+	{0xB840, 0xB840, LB_H2},
+	{0xB841, 0xB85B, LB_H3},
+	{0xB85C, 0xB85C, LB_H2},
+	{0xB85D, 0xB877, LB_H3},
+	{0xB878, 0xB878, LB_H2},
+	{0xB879, 0xB893, LB_H3},
+	{0xB894, 0xB894, LB_H2},
+	{0xB895, 0xB8AF, LB_H3},
+	{0xB8B0, 0xB8B0, LB_H2},
+	{0xB8B1, 0xB8CB, LB_H3},
+	{0xB8CC, 0xB8CC, LB_H2},
+	{0xB8CD, 0xB8E7, LB_H3},
+	{0xB8E8, 0xB8E8, LB_H2},
+	{0xB8E9, 0xB903, LB_H3},
+	{0xB904, 0xB904, LB_H2},
+	{0xB905, 0xB91F, LB_H3},
+	{0xB920, 0xB920, LB_H2},
+	{0xB921, 0xB93B, LB_H3},
+	{0xB93C, 0xB93C, LB_H2},
+	{0xB93D, 0xB957, LB_H3},
+	{0xB958, 0xB958, LB_H2},
+	{0xB959, 0xB973, LB_H3},
+	{0xB974, 0xB974, LB_H2},
+	{0xB975, 0xB98F, LB_H3},
+	{0xB990, 0xB990, LB_H2},
+	{0xB991, 0xB9AB, LB_H3},
+	{0xB9AC, 0xB9AC, LB_H2},
+	{0xB9AD, 0xB9C7, LB_H3},
+	{0xB9C8, 0xB9C8, LB_H2},
+	{0xB9C9, 0xB9E3, LB_H3},
+	{0xB9E4, 0xB9E4, LB_H2},
+	{0xB9E5, 0xB9FF, LB_H3},
+	{0xBA00, 0xBA00, LB_H2},
+	{0xBA01, 0xBA1B, LB_H3},
+	{0xBA1C, 0xBA1C, LB_H2},
+	{0xBA1D, 0xBA37, LB_H3},
+	{0xBA38, 0xBA38, LB_H2},
+	{0xBA39, 0xBA53, LB_H3},
+	{0xBA54, 0xBA54, LB_H2},
+	{0xBA55, 0xBA6F, LB_H3},
+	{0xBA70, 0xBA70, LB_H2},
+	{0xBA71, 0xBA8B, LB_H3},
+	{0xBA8C, 0xBA8C, LB_H2},
+	{0xBA8D, 0xBAA7, LB_H3},
+	{0xBAA8, 0xBAA8, LB_H2},
+	{0xBAA9, 0xBAC3, LB_H3},
+	{0xBAC4, 0xBAC4, LB_H2},
+	{0xBAC5, 0xBADF, LB_H3},
+	{0xBAE0, 0xBAE0, LB_H2},
+	{0xBAE1, 0xBAFB, LB_H3},
+	{0xBAFC, 0xBAFC, LB_H2},
+	{0xBAFD, 0xBB17, LB_H3},
+	{0xBB18, 0xBB18, LB_H2},
+	{0xBB19, 0xBB33, LB_H3},
+	{0xBB34, 0xBB34, LB_H2},
+	{0xBB35, 0xBB4F, LB_H3},
+	{0xBB50, 0xBB50, LB_H2},
+	{0xBB51, 0xBB6B, LB_H3},
+	{0xBB6C, 0xBB6C, LB_H2},
+	{0xBB6D, 0xBB87, LB_H3},
+	{0xBB88, 0xBB88, LB_H2},
+	{0xBB89, 0xBBA3, LB_H3},
+	{0xBBA4, 0xBBA4, LB_H2},
+	{0xBBA5, 0xBBBF, LB_H3},
+	{0xBBC0, 0xBBC0, LB_H2},
+	{0xBBC1, 0xBBDB, LB_H3},
+	{0xBBDC, 0xBBDC, LB_H2},
+	{0xBBDD, 0xBBF7, LB_H3},
+	{0xBBF8, 0xBBF8, LB_H2},
+	{0xBBF9, 0xBC13, LB_H3},
+	{0xBC14, 0xBC14, LB_H2},
+	{0xBC15, 0xBC2F, LB_H3},
+	{0xBC30, 0xBC30, LB_H2},
+	{0xBC31, 0xBC4B, LB_H3},
+	{0xBC4C, 0xBC4C, LB_H2},
+	{0xBC4D, 0xBC67, LB_H3},
+	{0xBC68, 0xBC68, LB_H2},
+	{0xBC69, 0xBC83, LB_H3},
+	{0xBC84, 0xBC84, LB_H2},
+	{0xBC85, 0xBC9F, LB_H3},
+	{0xBCA0, 0xBCA0, LB_H2},
+	{0xBCA1, 0xBCBB, LB_H3},
+	{0xBCBC, 0xBCBC, LB_H2},
+	{0xBCBD, 0xBCD7, LB_H3},
+	{0xBCD8, 0xBCD8, LB_H2},
+	{0xBCD9, 0xBCF3, LB_H3},
+	{0xBCF4, 0xBCF4, LB_H2},
+	{0xBCF5, 0xBD0F, LB_H3},
+	{0xBD10, 0xBD10, LB_H2},
+	{0xBD11, 0xBD2B, LB_H3},
+	{0xBD2C, 0xBD2C, LB_H2},
+	{0xBD2D, 0xBD47, LB_H3},
+	{0xBD48, 0xBD48, LB_H2},
+	{0xBD49, 0xBD63, LB_H3},
+	{0xBD64, 0xBD64, LB_H2},
+	{0xBD65, 0xBD7F, LB_H3},
+	{0xBD80, 0xBD80, LB_H2},
+	{0xBD81, 0xBD9B, LB_H3},
+	{0xBD9C, 0xBD9C, LB_H2},
+	{0xBD9D, 0xBDB7, LB_H3},
+	{0xBDB8, 0xBDB8, LB_H2},
+	{0xBDB9, 0xBDD3, LB_H3},
+	{0xBDD4, 0xBDD4, LB_H2},
+	{0xBDD5, 0xBDEF, LB_H3},
+	{0xBDF0, 0xBDF0, LB_H2},
+	{0xBDF1, 0xBE0B, LB_H3},
+	{0xBE0C, 0xBE0C, LB_H2},
+	{0xBE0D, 0xBE27, LB_H3},
+	{0xBE28, 0xBE28, LB_H2},
+	{0xBE29, 0xBE43, LB_H3},
+	{0xBE44, 0xBE44, LB_H2},
+	{0xBE45, 0xBE5F, LB_H3},
+	{0xBE60, 0xBE60, LB_H2},
+	{0xBE61, 0xBE7B, LB_H3},
+	{0xBE7C, 0xBE7C, LB_H2},
+	{0xBE7D, 0xBE97, LB_H3},
+	{0xBE98, 0xBE98, LB_H2},
+	{0xBE99, 0xBEB3, LB_H3},
+	{0xBEB4, 0xBEB4, LB_H2},
+	{0xBEB5, 0xBECF, LB_H3},
+	{0xBED0, 0xBED0, LB_H2},
+	{0xBED1, 0xBEEB, LB_H3},
+	{0xBEEC, 0xBEEC, LB_H2},
+	{0xBEED, 0xBF07, LB_H3},
+	{0xBF08, 0xBF08, LB_H2},
+	{0xBF09, 0xBF23, LB_H3},
+	{0xBF24, 0xBF24, LB_H2},
+	{0xBF25, 0xBF3F, LB_H3},
+	{0xBF40, 0xBF40, LB_H2},
+	{0xBF41, 0xBF5B, LB_H3},
+	{0xBF5C, 0xBF5C, LB_H2},
+	{0xBF5D, 0xBF77, LB_H3},
+	{0xBF78, 0xBF78, LB_H2},
+	{0xBF79, 0xBF93, LB_H3},
+	{0xBF94, 0xBF94, LB_H2},
+	{0xBF95, 0xBFAF, LB_H3},
+	{0xBFB0, 0xBFB0, LB_H2},
+	{0xBFB1, 0xBFCB, LB_H3},
+	{0xBFCC, 0xBFCC, LB_H2},
+	{0xBFCD, 0xBFE7, LB_H3},
+	{0xBFE8, 0xBFE8, LB_H2},
+	{0xBFE9, 0xC003, LB_H3},
+	{0xC004, 0xC004, LB_H2},
+	{0xC005, 0xC01F, LB_H3},
+	{0xC020, 0xC020, LB_H2},
+	{0xC021, 0xC03B, LB_H3},
+	{0xC03C, 0xC03C, LB_H2},
+	{0xC03D, 0xC057, LB_H3},
+	{0xC058, 0xC058, LB_H2},
+	{0xC059, 0xC073, LB_H3},
+	{0xC074, 0xC074, LB_H2},
+	{0xC075, 0xC08F, LB_H3},
+	{0xC090, 0xC090, LB_H2},
+	{0xC091, 0xC0AB, LB_H3},
+	{0xC0AC, 0xC0AC, LB_H2},
+	{0xC0AD, 0xC0C7, LB_H3},
+	{0xC0C8, 0xC0C8, LB_H2},
+	{0xC0C9, 0xC0E3, LB_H3},
+	{0xC0E4, 0xC0E4, LB_H2},
+	{0xC0E5, 0xC0FF, LB_H3},
+	{0xC100, 0xC100, LB_H2},
+	{0xC101, 0xC11B, LB_H3},
+	{0xC11C, 0xC11C, LB_H2},
+	{0xC11D, 0xC137, LB_H3},
+	{0xC138, 0xC138, LB_H2},
+	{0xC139, 0xC153, LB_H3},
+	{0xC154, 0xC154, LB_H2},
+	{0xC155, 0xC16F, LB_H3},
+	{0xC170, 0xC170, LB_H2},
+	{0xC171, 0xC18B, LB_H3},
+	{0xC18C, 0xC18C, LB_H2},
+	{0xC18D, 0xC1A7, LB_H3},
+	{0xC1A8, 0xC1A8, LB_H2},
+	{0xC1A9, 0xC1C3, LB_H3},
+	{0xC1C4, 0xC1C4, LB_H2},
+	{0xC1C5, 0xC1DF, LB_H3},
+	{0xC1E0, 0xC1E0, LB_H2},
+	{0xC1E1, 0xC1FB, LB_H3},
+	{0xC1FC, 0xC1FC, LB_H2},
+	{0xC1FD, 0xC217, LB_H3},
+	{0xC218, 0xC218, LB_H2},
+	{0xC219, 0xC233, LB_H3},
+	{0xC234, 0xC234, LB_H2},
+	{0xC235, 0xC24F, LB_H3},
+	{0xC250, 0xC250, LB_H2},
+	{0xC251, 0xC26B, LB_H3},
+	{0xC26C, 0xC26C, LB_H2},
+	{0xC26D, 0xC287, LB_H3},
+	{0xC288, 0xC288, LB_H2},
+	{0xC289, 0xC2A3, LB_H3},
+	{0xC2A4, 0xC2A4, LB_H2},
+	{0xC2A5, 0xC2BF, LB_H3},
+	{0xC2C0, 0xC2C0, LB_H2},
+	{0xC2C1, 0xC2DB, LB_H3},
+	{0xC2DC, 0xC2DC, LB_H2},
+	{0xC2DD, 0xC2F7, LB_H3},
+	{0xC2F8, 0xC2F8, LB_H2},
+	{0xC2F9, 0xC313, LB_H3},
+	{0xC314, 0xC314, LB_H2},
+	{0xC315, 0xC32F, LB_H3},
+	{0xC330, 0xC330, LB_H2},
+	{0xC331, 0xC34B, LB_H3},
+	{0xC34C, 0xC34C, LB_H2},
+	{0xC34D, 0xC367, LB_H3},
+	{0xC368, 0xC368, LB_H2},
+	{0xC369, 0xC383, LB_H3},
+	{0xC384, 0xC384, LB_H2},
+	{0xC385, 0xC39F, LB_H3},
+	{0xC3A0, 0xC3A0, LB_H2},
+	{0xC3A1, 0xC3BB, LB_H3},
+	{0xC3BC, 0xC3BC, LB_H2},
+	{0xC3BD, 0xC3D7, LB_H3},
+	{0xC3D8, 0xC3D8, LB_H2},
+	{0xC3D9, 0xC3F3, LB_H3},
+	{0xC3F4, 0xC3F4, LB_H2},
+	{0xC3F5, 0xC40F, LB_H3},
+	{0xC410, 0xC410, LB_H2},
+	{0xC411, 0xC42B, LB_H3},
+	{0xC42C, 0xC42C, LB_H2},
+	{0xC42D, 0xC447, LB_H3},
+	{0xC448, 0xC448, LB_H2},
+	{0xC449, 0xC463, LB_H3},
+	{0xC464, 0xC464, LB_H2},
+	{0xC465, 0xC47F, LB_H3},
+	{0xC480, 0xC480, LB_H2},
+	{0xC481, 0xC49B, LB_H3},
+	{0xC49C, 0xC49C, LB_H2},
+	{0xC49D, 0xC4B7, LB_H3},
+	{0xC4B8, 0xC4B8, LB_H2},
+	{0xC4B9, 0xC4D3, LB_H3},
+	{0xC4D4, 0xC4D4, LB_H2},
+	{0xC4D5, 0xC4EF, LB_H3},
+	{0xC4F0, 0xC4F0, LB_H2},
+	{0xC4F1, 0xC50B, LB_H3},
+	{0xC50C, 0xC50C, LB_H2},
+	{0xC50D, 0xC527, LB_H3},
+	{0xC528, 0xC528, LB_H2},
+	{0xC529, 0xC543, LB_H3},
+	{0xC544, 0xC544, LB_H2},
+	{0xC545, 0xC55F, LB_H3},
+	{0xC560, 0xC560, LB_H2},
+	{0xC561, 0xC57B, LB_H3},
+	{0xC57C, 0xC57C, LB_H2},
+	{0xC57D, 0xC597, LB_H3},
+	{0xC598, 0xC598, LB_H2},
+	{0xC599, 0xC5B3, LB_H3},
+	{0xC5B4, 0xC5B4, LB_H2},
+	{0xC5B5, 0xC5CF, LB_H3},
+	{0xC5D0, 0xC5D0, LB_H2},
+	{0xC5D1, 0xC5EB, LB_H3},
+	{0xC5EC, 0xC5EC, LB_H2},
+	{0xC5ED, 0xC607, LB_H3},
+	{0xC608, 0xC608, LB_H2},
+	{0xC609, 0xC623, LB_H3},
+	{0xC624, 0xC624, LB_H2},
+	{0xC625, 0xC63F, LB_H3},
+	{0xC640, 0xC640, LB_H2},
+	{0xC641, 0xC65B, LB_H3},
+	{0xC65C, 0xC65C, LB_H2},
+	{0xC65D, 0xC677, LB_H3},
+	{0xC678, 0xC678, LB_H2},
+	{0xC679, 0xC693, LB_H3},
+	{0xC694, 0xC694, LB_H2},
+	{0xC695, 0xC6AF, LB_H3},
+	{0xC6B0, 0xC6B0, LB_H2},
+	{0xC6B1, 0xC6CB, LB_H3},
+	{0xC6CC, 0xC6CC, LB_H2},
+	{0xC6CD, 0xC6E7, LB_H3},
+	{0xC6E8, 0xC6E8, LB_H2},
+	{0xC6E9, 0xC703, LB_H3},
+	{0xC704, 0xC704, LB_H2},
+	{0xC705, 0xC71F, LB_H3},
+	{0xC720, 0xC720, LB_H2},
+	{0xC721, 0xC73B, LB_H3},
+	{0xC73C, 0xC73C, LB_H2},
+	{0xC73D, 0xC757, LB_H3},
+	{0xC758, 0xC758, LB_H2},
+	{0xC759, 0xC773, LB_H3},
+	{0xC774, 0xC774, LB_H2},
+	{0xC775, 0xC78F, LB_H3},
+	{0xC790, 0xC790, LB_H2},
+	{0xC791, 0xC7AB, LB_H3},
+	{0xC7AC, 0xC7AC, LB_H2},
+	{0xC7AD, 0xC7C7, LB_H3},
+	{0xC7C8, 0xC7C8, LB_H2},
+	{0xC7C9, 0xC7E3, LB_H3},
+	{0xC7E4, 0xC7E4, LB_H2},
+	{0xC7E5, 0xC7FF, LB_H3},
+	{0xC800, 0xC800, LB_H2},
+	{0xC801, 0xC81B, LB_H3},
+	{0xC81C, 0xC81C, LB_H2},
+	{0xC81D, 0xC837, LB_H3},
+	{0xC838, 0xC838, LB_H2},
+	{0xC839, 0xC853, LB_H3},
+	{0xC854, 0xC854, LB_H2},
+	{0xC855, 0xC86F, LB_H3},
+	{0xC870, 0xC870, LB_H2},
+	{0xC871, 0xC88B, LB_H3},
+	{0xC88C, 0xC88C, LB_H2},
+	{0xC88D, 0xC8A7, LB_H3},
+	{0xC8A8, 0xC8A8, LB_H2},
+	{0xC8A9, 0xC8C3, LB_H3},
+	{0xC8C4, 0xC8C4, LB_H2},
+	{0xC8C5, 0xC8DF, LB_H3},
+	{0xC8E0, 0xC8E0, LB_H2},
+	{0xC8E1, 0xC8FB, LB_H3},
+	{0xC8FC, 0xC8FC, LB_H2},
+	{0xC8FD, 0xC917, LB_H3},
+	{0xC918, 0xC918, LB_H2},
+	{0xC919, 0xC933, LB_H3},
+	{0xC934, 0xC934, LB_H2},
+	{0xC935, 0xC94F, LB_H3},
+	{0xC950, 0xC950, LB_H2},
+	{0xC951, 0xC96B, LB_H3},
+	{0xC96C, 0xC96C, LB_H2},
+	{0xC96D, 0xC987, LB_H3},
+	{0xC988, 0xC988, LB_H2},
+	{0xC989, 0xC9A3, LB_H3},
+	{0xC9A4, 0xC9A4, LB_H2},
+	{0xC9A5, 0xC9BF, LB_H3},
+	{0xC9C0, 0xC9C0, LB_H2},
+	{0xC9C1, 0xC9DB, LB_H3},
+	{0xC9DC, 0xC9DC, LB_H2},
+	{0xC9DD, 0xC9F7, LB_H3},
+	{0xC9F8, 0xC9F8, LB_H2},
+	{0xC9F9, 0xCA13, LB_H3},
+	{0xCA14, 0xCA14, LB_H2},
+	{0xCA15, 0xCA2F, LB_H3},
+	{0xCA30, 0xCA30, LB_H2},
+	{0xCA31, 0xCA4B, LB_H3},
+	{0xCA4C, 0xCA4C, LB_H2},
+	{0xCA4D, 0xCA67, LB_H3},
+	{0xCA68, 0xCA68, LB_H2},
+	{0xCA69, 0xCA83, LB_H3},
+	{0xCA84, 0xCA84, LB_H2},
+	{0xCA85, 0xCA9F, LB_H3},
+	{0xCAA0, 0xCAA0, LB_H2},
+	{0xCAA1, 0xCABB, LB_H3},
+	{0xCABC, 0xCABC, LB_H2},
+	{0xCABD, 0xCAD7, LB_H3},
+	{0xCAD8, 0xCAD8, LB_H2},
+	{0xCAD9, 0xCAF3, LB_H3},
+	{0xCAF4, 0xCAF4, LB_H2},
+	{0xCAF5, 0xCB0F, LB_H3},
+	{0xCB10, 0xCB10, LB_H2},
+	{0xCB11, 0xCB2B, LB_H3},
+	{0xCB2C, 0xCB2C, LB_H2},
+	{0xCB2D, 0xCB47, LB_H3},
+	{0xCB48, 0xCB48, LB_H2},
+	{0xCB49, 0xCB63, LB_H3},
+	{0xCB64, 0xCB64, LB_H2},
+	{0xCB65, 0xCB7F, LB_H3},
+	{0xCB80, 0xCB80, LB_H2},
+	{0xCB81, 0xCB9B, LB_H3},
+	{0xCB9C, 0xCB9C, LB_H2},
+	{0xCB9D, 0xCBB7, LB_H3},
+	{0xCBB8, 0xCBB8, LB_H2},
+	{0xCBB9, 0xCBD3, LB_H3},
+	{0xCBD4, 0xCBD4, LB_H2},
+	{0xCBD5, 0xCBEF, LB_H3},
+	{0xCBF0, 0xCBF0, LB_H2},
+	{0xCBF1, 0xCC0B, LB_H3},
+	{0xCC0C, 0xCC0C, LB_H2},
+	{0xCC0D, 0xCC27, LB_H3},
+	{0xCC28, 0xCC28, LB_H2},
+	{0xCC29, 0xCC43, LB_H3},
+	{0xCC44, 0xCC44, LB_H2},
+	{0xCC45, 0xCC5F, LB_H3},
+	{0xCC60, 0xCC60, LB_H2},
+	{0xCC61, 0xCC7B, LB_H3},
+	{0xCC7C, 0xCC7C, LB_H2},
+	{0xCC7D, 0xCC97, LB_H3},
+	{0xCC98, 0xCC98, LB_H2},
+	{0xCC99, 0xCCB3, LB_H3},
+	{0xCCB4, 0xCCB4, LB_H2},
+	{0xCCB5, 0xCCCF, LB_H3},
+	{0xCCD0, 0xCCD0, LB_H2},
+	{0xCCD1, 0xCCEB, LB_H3},
+	{0xCCEC, 0xCCEC, LB_H2},
+	{0xCCED, 0xCD07, LB_H3},
+	{0xCD08, 0xCD08, LB_H2},
+	{0xCD09, 0xCD23, LB_H3},
+	{0xCD24, 0xCD24, LB_H2},
+	{0xCD25, 0xCD3F, LB_H3},
+	{0xCD40, 0xCD40, LB_H2},
+	{0xCD41, 0xCD5B, LB_H3},
+	{0xCD5C, 0xCD5C, LB_H2},
+	{0xCD5D, 0xCD77, LB_H3},
+	{0xCD78, 0xCD78, LB_H2},
+	{0xCD79, 0xCD93, LB_H3},
+	{0xCD94, 0xCD94, LB_H2},
+	{0xCD95, 0xCDAF, LB_H3},
+	{0xCDB0, 0xCDB0, LB_H2},
+	{0xCDB1, 0xCDCB, LB_H3},
+	{0xCDCC, 0xCDCC, LB_H2},
+	{0xCDCD, 0xCDE7, LB_H3},
+	{0xCDE8, 0xCDE8, LB_H2},
+	{0xCDE9, 0xCE03, LB_H3},
+	{0xCE04, 0xCE04, LB_H2},
+	{0xCE05, 0xCE1F, LB_H3},
+	{0xCE20, 0xCE20, LB_H2},
+	{0xCE21, 0xCE3B, LB_H3},
+	{0xCE3C, 0xCE3C, LB_H2},
+	{0xCE3D, 0xCE57, LB_H3},
+	{0xCE58, 0xCE58, LB_H2},
+	{0xCE59, 0xCE73, LB_H3},
+	{0xCE74, 0xCE74, LB_H2},
+	{0xCE75, 0xCE8F, LB_H3},
+	{0xCE90, 0xCE90, LB_H2},
+	{0xCE91, 0xCEAB, LB_H3},
+	{0xCEAC, 0xCEAC, LB_H2},
+	{0xCEAD, 0xCEC7, LB_H3},
+	{0xCEC8, 0xCEC8, LB_H2},
+	{0xCEC9, 0xCEE3, LB_H3},
+	{0xCEE4, 0xCEE4, LB_H2},
+	{0xCEE5, 0xCEFF, LB_H3},
+	{0xCF00, 0xCF00, LB_H2},
+	{0xCF01, 0xCF1B, LB_H3},
+	{0xCF1C, 0xCF1C, LB_H2},
+	{0xCF1D, 0xCF37, LB_H3},
+	{0xCF38, 0xCF38, LB_H2},
+	{0xCF39, 0xCF53, LB_H3},
+	{0xCF54, 0xCF54, LB_H2},
+	{0xCF55, 0xCF6F, LB_H3},
+	{0xCF70, 0xCF70, LB_H2},
+	{0xCF71, 0xCF8B, LB_H3},
+	{0xCF8C, 0xCF8C, LB_H2},
+	{0xCF8D, 0xCFA7, LB_H3},
+	{0xCFA8, 0xCFA8, LB_H2},
+	{0xCFA9, 0xCFC3, LB_H3},
+	{0xCFC4, 0xCFC4, LB_H2},
+	{0xCFC5, 0xCFDF, LB_H3},
+	{0xCFE0, 0xCFE0, LB_H2},
+	{0xCFE1, 0xCFFB, LB_H3},
+	{0xCFFC, 0xCFFC, LB_H2},
+	{0xCFFD, 0xD017, LB_H3},
+	{0xD018, 0xD018, LB_H2},
+	{0xD019, 0xD033, LB_H3},
+	{0xD034, 0xD034, LB_H2},
+	{0xD035, 0xD04F, LB_H3},
+	{0xD050, 0xD050, LB_H2},
+	{0xD051, 0xD06B, LB_H3},
+	{0xD06C, 0xD06C, LB_H2},
+	{0xD06D, 0xD087, LB_H3},
+	{0xD088, 0xD088, LB_H2},
+	{0xD089, 0xD0A3, LB_H3},
+	{0xD0A4, 0xD0A4, LB_H2},
+	{0xD0A5, 0xD0BF, LB_H3},
+	{0xD0C0, 0xD0C0, LB_H2},
+	{0xD0C1, 0xD0DB, LB_H3},
+	{0xD0DC, 0xD0DC, LB_H2},
+	{0xD0DD, 0xD0F7, LB_H3},
+	{0xD0F8, 0xD0F8, LB_H2},
+	{0xD0F9, 0xD113, LB_H3},
+	{0xD114, 0xD114, LB_H2},
+	{0xD115, 0xD12F, LB_H3},
+	{0xD130, 0xD130, LB_H2},
+	{0xD131, 0xD14B, LB_H3},
+	{0xD14C, 0xD14C, LB_H2},
+	{0xD14D, 0xD167, LB_H3},
+	{0xD168, 0xD168, LB_H2},
+	{0xD169, 0xD183, LB_H3},
+	{0xD184, 0xD184, LB_H2},
+	{0xD185, 0xD19F, LB_H3},
+	{0xD1A0, 0xD1A0, LB_H2},
+	{0xD1A1, 0xD1BB, LB_H3},
+	{0xD1BC, 0xD1BC, LB_H2},
+	{0xD1BD, 0xD1D7, LB_H3},
+	{0xD1D8, 0xD1D8, LB_H2},
+	{0xD1D9, 0xD1F3, LB_H3},
+	{0xD1F4, 0xD1F4, LB_H2},
+	{0xD1F5, 0xD20F, LB_H3},
+	{0xD210, 0xD210, LB_H2},
+	{0xD211, 0xD22B, LB_H3},
+	{0xD22C, 0xD22C, LB_H2},
+	{0xD22D, 0xD247, LB_H3},
+	{0xD248, 0xD248, LB_H2},
+	{0xD249, 0xD263, LB_H3},
+	{0xD264, 0xD264, LB_H2},
+	{0xD265, 0xD27F, LB_H3},
+	{0xD280, 0xD280, LB_H2},
+	{0xD281, 0xD29B, LB_H3},
+	{0xD29C, 0xD29C, LB_H2},
+	{0xD29D, 0xD2B7, LB_H3},
+	{0xD2B8, 0xD2B8, LB_H2},
+	{0xD2B9, 0xD2D3, LB_H3},
+	{0xD2D4, 0xD2D4, LB_H2},
+	{0xD2D5, 0xD2EF, LB_H3},
+	{0xD2F0, 0xD2F0, LB_H2},
+	{0xD2F1, 0xD30B, LB_H3},
+	{0xD30C, 0xD30C, LB_H2},
+	{0xD30D, 0xD327, LB_H3},
+	{0xD328, 0xD328, LB_H2},
+	{0xD329, 0xD343, LB_H3},
+	{0xD344, 0xD344, LB_H2},
+	{0xD345, 0xD35F, LB_H3},
+	{0xD360, 0xD360, LB_H2},
+	{0xD361, 0xD37B, LB_H3},
+	{0xD37C, 0xD37C, LB_H2},
+	{0xD37D, 0xD397, LB_H3},
+	{0xD398, 0xD398, LB_H2},
+	{0xD399, 0xD3B3, LB_H3},
+	{0xD3B4, 0xD3B4, LB_H2},
+	{0xD3B5, 0xD3CF, LB_H3},
+	{0xD3D0, 0xD3D0, LB_H2},
+	{0xD3D1, 0xD3EB, LB_H3},
+	{0xD3EC, 0xD3EC, LB_H2},
+	{0xD3ED, 0xD407, LB_H3},
+	{0xD408, 0xD408, LB_H2},
+	{0xD409, 0xD423, LB_H3},
+	{0xD424, 0xD424, LB_H2},
+	{0xD425, 0xD43F, LB_H3},
+	{0xD440, 0xD440, LB_H2},
+	{0xD441, 0xD45B, LB_H3},
+	{0xD45C, 0xD45C, LB_H2},
+	{0xD45D, 0xD477, LB_H3},
+	{0xD478, 0xD478, LB_H2},
+	{0xD479, 0xD493, LB_H3},
+	{0xD494, 0xD494, LB_H2},
+	{0xD495, 0xD4AF, LB_H3},
+	{0xD4B0, 0xD4B0, LB_H2},
+	{0xD4B1, 0xD4CB, LB_H3},
+	{0xD4CC, 0xD4CC, LB_H2},
+	{0xD4CD, 0xD4E7, LB_H3},
+	{0xD4E8, 0xD4E8, LB_H2},
+	{0xD4E9, 0xD503, LB_H3},
+	{0xD504, 0xD504, LB_H2},
+	{0xD505, 0xD51F, LB_H3},
+	{0xD520, 0xD520, LB_H2},
+	{0xD521, 0xD53B, LB_H3},
+	{0xD53C, 0xD53C, LB_H2},
+	{0xD53D, 0xD557, LB_H3},
+	{0xD558, 0xD558, LB_H2},
+	{0xD559, 0xD573, LB_H3},
+	{0xD574, 0xD574, LB_H2},
+	{0xD575, 0xD58F, LB_H3},
+	{0xD590, 0xD590, LB_H2},
+	{0xD591, 0xD5AB, LB_H3},
+	{0xD5AC, 0xD5AC, LB_H2},
+	{0xD5AD, 0xD5C7, LB_H3},
+	{0xD5C8, 0xD5C8, LB_H2},
+	{0xD5C9, 0xD5E3, LB_H3},
+	{0xD5E4, 0xD5E4, LB_H2},
+	{0xD5E5, 0xD5FF, LB_H3},
+	{0xD600, 0xD600, LB_H2},
+	{0xD601, 0xD61B, LB_H3},
+	{0xD61C, 0xD61C, LB_H2},
+	{0xD61D, 0xD637, LB_H3},
+	{0xD638, 0xD638, LB_H2},
+	{0xD639, 0xD653, LB_H3},
+	{0xD654, 0xD654, LB_H2},
+	{0xD655, 0xD66F, LB_H3},
+	{0xD670, 0xD670, LB_H2},
+	{0xD671, 0xD68B, LB_H3},
+	{0xD68C, 0xD68C, LB_H2},
+	{0xD68D, 0xD6A7, LB_H3},
+	{0xD6A8, 0xD6A8, LB_H2},
+	{0xD6A9, 0xD6C3, LB_H3},
+	{0xD6C4, 0xD6C4, LB_H2},
+	{0xD6C5, 0xD6DF, LB_H3},
+	{0xD6E0, 0xD6E0, LB_H2},
+	{0xD6E1, 0xD6FB, LB_H3},
+	{0xD6FC, 0xD6FC, LB_H2},
+	{0xD6FD, 0xD717, LB_H3},
+	{0xD718, 0xD718, LB_H2},
+	{0xD719, 0xD733, LB_H3},
+	{0xD734, 0xD734, LB_H2},
+	{0xD735, 0xD74F, LB_H3},
+	{0xD750, 0xD750, LB_H2},
+	{0xD751, 0xD76B, LB_H3},
+	{0xD76C, 0xD76C, LB_H2},
+	{0xD76D, 0xD787, LB_H3},
+	{0xD788, 0xD788, LB_H2},
+	{0xD789, 0xD7A3, LB_H3},
+	{0xD7B0, 0xD7C6, LB_JV},
+	{0xD7CB, 0xD7FB, LB_JT},
+	{0xD800, 0xDFFF, LB_SG},
+	{0xF900, 0xFAFF, LB_ID},
+	{0xFB00, 0xFB06, LB_AL},
+	{0xFB13, 0xFB17, LB_AL},
+	{0xFB1D, 0xFB1D, LB_HL},
+	{0xFB1E, 0xFB1E, LB_CM},
+	{0xFB1F, 0xFB28, LB_HL},
+	{0xFB29, 0xFB29, LB_AL},
+	{0xFB2A, 0xFB36, LB_HL},
+	{0xFB38, 0xFB3C, LB_HL},
+	{0xFB3E, 0xFB3E, LB_HL},
+	{0xFB40, 0xFB41, LB_HL},
+	{0xFB43, 0xFB44, LB_HL},
+	{0xFB46, 0xFB4F, LB_HL},
+	{0xFB50, 0xFBC2, LB_AL},
+	{0xFBD3, 0xFD3D, LB_AL},
+	{0xFD3E, 0xFD3E, LB_CL},
+	{0xFD3F, 0xFD3F, LB_OP},
+	{0xFD40, 0xFD8F, LB_AL},
+	{0xFD92, 0xFDC7, LB_AL},
+	{0xFDCF, 0xFDCF, LB_AL},
+	{0xFDF0, 0xFDFB, LB_AL},
+	{0xFDFC, 0xFDFC, LB_PO},
+	{0xFDFD, 0xFDFF, LB_AL},
+	{0xFE00, 0xFE0F, LB_CM},
+	{0xFE10, 0xFE10, LB_IS},
+	{0xFE11, 0xFE12, LB_CL},
+	{0xFE13, 0xFE14, LB_IS},
+	{0xFE15, 0xFE16, LB_EX},
+	{0xFE17, 0xFE17, LB_OP},
+	{0xFE18, 0xFE18, LB_CL},
+	{0xFE19, 0xFE19, LB_IN},
+	{0xFE20, 0xFE2F, LB_CM},
+	{0xFE30, 0xFE34, LB_ID},
+	{0xFE35, 0xFE35, LB_OP},
+	{0xFE36, 0xFE36, LB_CL},
+	{0xFE37, 0xFE37, LB_OP},
+	{0xFE38, 0xFE38, LB_CL},
+	{0xFE39, 0xFE39, LB_OP},
+	{0xFE3A, 0xFE3A, LB_CL},
+	{0xFE3B, 0xFE3B, LB_OP},
+	{0xFE3C, 0xFE3C, LB_CL},
+	{0xFE3D, 0xFE3D, LB_OP},
+	{0xFE3E, 0xFE3E, LB_CL},
+	{0xFE3F, 0xFE3F, LB_OP},
+	{0xFE40, 0xFE40, LB_CL},
+	{0xFE41, 0xFE41, LB_OP},
+	{0xFE42, 0xFE42, LB_CL},
+	{0xFE43, 0xFE43, LB_OP},
+	{0xFE44, 0xFE44, LB_CL},
+	{0xFE45, 0xFE46, LB_ID},
+	{0xFE47, 0xFE47, LB_OP},
+	{0xFE48, 0xFE48, LB_CL},
+	{0xFE49, 0xFE4F, LB_ID},
+	{0xFE50, 0xFE50, LB_CL},
+	{0xFE51, 0xFE51, LB_ID},
+	{0xFE52, 0xFE52, LB_CL},
+	{0xFE54, 0xFE55, LB_NS},
+	{0xFE56, 0xFE57, LB_EX},
+	{0xFE58, 0xFE58, LB_ID},
+	{0xFE59, 0xFE59, LB_OP},
+	{0xFE5A, 0xFE5A, LB_CL},
+	{0xFE5B, 0xFE5B, LB_OP},
+	{0xFE5C, 0xFE5C, LB_CL},
+	{0xFE5D, 0xFE5D, LB_OP},
+	{0xFE5E, 0xFE5E, LB_CL},
+	{0xFE5F, 0xFE66, LB_ID},
+	{0xFE68, 0xFE68, LB_ID},
+	{0xFE69, 0xFE69, LB_PR},
+	{0xFE6A, 0xFE6A, LB_PO},
+	{0xFE6B, 0xFE6B, LB_ID},
+	{0xFE70, 0xFE74, LB_AL},
+	{0xFE76, 0xFEFC, LB_AL},
+	{0xFEFF, 0xFEFF, LB_WJ},
+	{0xFF01, 0xFF01, LB_EX},
+	{0xFF02, 0xFF03, LB_ID},
+	{0xFF04, 0xFF04, LB_PR},
+	{0xFF05, 0xFF05, LB_PO},
+	{0xFF06, 0xFF07, LB_ID},
+	{0xFF08, 0xFF08, LB_OP},
+	{0xFF09, 0xFF09, LB_CL},
+	{0xFF0A, 0xFF0B, LB_ID},
+	{0xFF0C, 0xFF0C, LB_CL},
+	{0xFF0D, 0xFF0D, LB_ID},
+	{0xFF0E, 0xFF0E, LB_CL},
+	{0xFF0F, 0xFF19, LB_ID},
+	{0xFF1A, 0xFF1B, LB_NS},
+	{0xFF1C, 0xFF1E, LB_ID},
+	{0xFF1F, 0xFF1F, LB_EX},
+	{0xFF20, 0xFF3A, LB_ID},
+	{0xFF3B, 0xFF3B, LB_OP},
+	{0xFF3C, 0xFF3C, LB_ID},
+	{0xFF3D, 0xFF3D, LB_CL},
+	{0xFF3E, 0xFF5A, LB_ID},
+	{0xFF5B, 0xFF5B, LB_OP},
+	{0xFF5C, 0xFF5C, LB_ID},
+	{0xFF5D, 0xFF5D, LB_CL},
+	{0xFF5E, 0xFF5E, LB_ID},
+	{0xFF5F, 0xFF5F, LB_OP},
+	{0xFF60, 0xFF61, LB_CL},
+	{0xFF62, 0xFF62, LB_OP},
+	{0xFF63, 0xFF64, LB_CL},
+	{0xFF65, 0xFF65, LB_NS},
+	{0xFF66, 0xFF66, LB_ID},
+	{0xFF67, 0xFF70, LB_CJ},
+	{0xFF71, 0xFF9D, LB_ID},
+	{0xFF9E, 0xFF9F, LB_NS},
+	{0xFFA0, 0xFFBE, LB_ID},
+	{0xFFC2, 0xFFC7, LB_ID},
+	{0xFFCA, 0xFFCF, LB_ID},
+	{0xFFD2, 0xFFD7, LB_ID},
+	{0xFFDA, 0xFFDC, LB_ID},
+	{0xFFE0, 0xFFE0, LB_PO},
+	{0xFFE1, 0xFFE1, LB_PR},
+	{0xFFE2, 0xFFE4, LB_ID},
+	{0xFFE5, 0xFFE6, LB_PR},
+	{0xFFE8, 0xFFEE, LB_AL},
+	{0xFFF9, 0xFFFB, LB_CM},
+	{0xFFFC, 0xFFFC, LB_CB},
+	{0xFFFD, 0xFFFD, LB_AI},
+	{0x10000, 0x1000B, LB_AL},
+	{0x1000D, 0x10026, LB_AL},
+	{0x10028, 0x1003A, LB_AL},
+	{0x1003C, 0x1003D, LB_AL},
+	{0x1003F, 0x1004D, LB_AL},
+	{0x10050, 0x1005D, LB_AL},
+	{0x10080, 0x100FA, LB_AL},
+	{0x10100, 0x10102, LB_BA},
+	{0x10107, 0x10133, LB_AL},
+	{0x10137, 0x1018E, LB_AL},
+	{0x10190, 0x1019C, LB_AL},
+	{0x101A0, 0x101A0, LB_AL},
+	{0x101D0, 0x101FC, LB_AL},
+	{0x101FD, 0x101FD, LB_CM},
+	{0x10280, 0x1029C, LB_AL},
+	{0x102A0, 0x102D0, LB_AL},
+	{0x102E0, 0x102E0, LB_CM},
+	{0x102E1, 0x102FB, LB_AL},
+	{0x10300, 0x10323, LB_AL},
+	{0x1032D, 0x1034A, LB_AL},
+	{0x10350, 0x10375, LB_AL},
+	{0x10376, 0x1037A, LB_CM},
+	{0x10380, 0x1039D, LB_AL},
+	{0x1039F, 0x1039F, LB_BA},
+	{0x103A0, 0x103C3, LB_AL},
+	{0x103C8, 0x103CF, LB_AL},
+	{0x103D0, 0x103D0, LB_BA},
+	{0x103D1, 0x103D5, LB_AL},
+	{0x10400, 0x1049D, LB_AL},
+	{0x104A0, 0x104A9, LB_NU},
+	{0x104B0, 0x104D3, LB_AL},
+	{0x104D8, 0x104FB, LB_AL},
+	{0x10500, 0x10527, LB_AL},
+	{0x10530, 0x10563, LB_AL},
+	{0x1056F, 0x1057A, LB_AL},
+	{0x1057C, 0x1058A, LB_AL},
+	{0x1058C, 0x10592, LB_AL},
+	{0x10594, 0x10595, LB_AL},
+	{0x10597, 0x105A1, LB_AL},
+	{0x105A3, 0x105B1, LB_AL},
+	{0x105B3, 0x105B9, LB_AL},
+	{0x105BB, 0x105BC, LB_AL},
+	{0x10600, 0x10736, LB_AL},
+	{0x10740, 0x10755, LB_AL},
+	{0x10760, 0x10767, LB_AL},
+	{0x10780, 0x10785, LB_AL},
+	{0x10787, 0x107B0, LB_AL},
+	{0x107B2, 0x107BA, LB_AL},
+	{0x10800, 0x10805, LB_AL},
+	{0x10808, 0x10808, LB_AL},
+	{0x1080A, 0x10835, LB_AL},
+	{0x10837, 0x10838, LB_AL},
+	{0x1083C, 0x1083C, LB_AL},
+	{0x1083F, 0x10855, LB_AL},
+	{0x10857, 0x10857, LB_BA},
+	{0x10858, 0x1089E, LB_AL},
+	{0x108A7, 0x108AF, LB_AL},
+	{0x108E0, 0x108F2, LB_AL},
+	{0x108F4, 0x108F5, LB_AL},
+	{0x108FB, 0x1091B, LB_AL},
+	{0x1091F, 0x1091F, LB_BA},
+	{0x10920, 0x10939, LB_AL},
+	{0x1093F, 0x1093F, LB_AL},
+	{0x10980, 0x109B7, LB_AL},
+	{0x109BC, 0x109CF, LB_AL},
+	{0x109D2, 0x10A00, LB_AL},
+	{0x10A01, 0x10A03, LB_CM},
+	{0x10A05, 0x10A06, LB_CM},
+	{0x10A0C, 0x10A0F, LB_CM},
+	{0x10A10, 0x10A13, LB_AL},
+	{0x10A15, 0x10A17, LB_AL},
+	{0x10A19, 0x10A35, LB_AL},
+	{0x10A38, 0x10A3A, LB_CM},
+	{0x10A3F, 0x10A3F, LB_CM},
+	{0x10A40, 0x10A48, LB_AL},
+	{0x10A50, 0x10A57, LB_BA},
+	{0x10A58, 0x10A58, LB_AL},
+	{0x10A60, 0x10A9F, LB_AL},
+	{0x10AC0, 0x10AE4, LB_AL},
+	{0x10AE5, 0x10AE6, LB_CM},
+	{0x10AEB, 0x10AEF, LB_AL},
+	{0x10AF0, 0x10AF5, LB_BA},
+	{0x10AF6, 0x10AF6, LB_IN},
+	{0x10B00, 0x10B35, LB_AL},
+	{0x10B39, 0x10B3F, LB_BA},
+	{0x10B40, 0x10B55, LB_AL},
+	{0x10B58, 0x10B72, LB_AL},
+	{0x10B78, 0x10B91, LB_AL},
+	{0x10B99, 0x10B9C, LB_AL},
+	{0x10BA9, 0x10BAF, LB_AL},
+	{0x10C00, 0x10C48, LB_AL},
+	{0x10C80, 0x10CB2, LB_AL},
+	{0x10CC0, 0x10CF2, LB_AL},
+	{0x10CFA, 0x10D23, LB_AL},
+	{0x10D24, 0x10D27, LB_CM},
+	{0x10D30, 0x10D39, LB_NU},
+	{0x10E60, 0x10E7E, LB_AL},
+	{0x10E80, 0x10EA9, LB_AL},
+	{0x10EAB, 0x10EAC, LB_CM},
+	{0x10EAD, 0x10EAD, LB_BA},
+	{0x10EB0, 0x10EB1, LB_AL},
+	{0x10F00, 0x10F27, LB_AL},
+	{0x10F30, 0x10F45, LB_AL},
+	{0x10F46, 0x10F50, LB_CM},
+	{0x10F51, 0x10F59, LB_AL},
+	{0x10F70, 0x10F81, LB_AL},
+	{0x10F82, 0x10F85, LB_CM},
+	{0x10F86, 0x10F89, LB_AL},
+	{0x10FB0, 0x10FCB, LB_AL},
+	{0x10FE0, 0x10FF6, LB_AL},
+	{0x11000, 0x11002, LB_CM},
+	{0x11003, 0x11037, LB_AL},
+	{0x11038, 0x11046, LB_CM},
+	{0x11047, 0x11048, LB_BA},
+	{0x11049, 0x1104D, LB_AL},
+	{0x11052, 0x11065, LB_AL},
+	{0x11066, 0x1106F, LB_NU},
+	{0x11070, 0x11070, LB_CM},
+	{0x11071, 0x11072, LB_AL},
+	{0x11073, 0x11074, LB_CM},
+	{0x11075, 0x11075, LB_AL},
+	{0x1107F, 0x11082, LB_CM},
+	{0x11083, 0x110AF, LB_AL},
+	{0x110B0, 0x110BA, LB_CM},
+	{0x110BB, 0x110BD, LB_AL},
+	{0x110BE, 0x110C1, LB_BA},
+	{0x110C2, 0x110C2, LB_CM},
+	{0x110CD, 0x110CD, LB_AL},
+	{0x110D0, 0x110E8, LB_AL},
+	{0x110F0, 0x110F9, LB_NU},
+	{0x11100, 0x11102, LB_CM},
+	{0x11103, 0x11126, LB_AL},
+	{0x11127, 0x11134, LB_CM},
+	{0x11136, 0x1113F, LB_NU},
+	{0x11140, 0x11143, LB_BA},
+	{0x11144, 0x11144, LB_AL},
+	{0x11145, 0x11146, LB_CM},
+	{0x11147, 0x11147, LB_AL},
+	{0x11150, 0x11172, LB_AL},
+	{0x11173, 0x11173, LB_CM},
+	{0x11174, 0x11174, LB_AL},
+	{0x11175, 0x11175, LB_BB},
+	{0x11176, 0x11176, LB_AL},
+	{0x11180, 0x11182, LB_CM},
+	{0x11183, 0x111B2, LB_AL},
+	{0x111B3, 0x111C0, LB_CM},
+	{0x111C1, 0x111C4, LB_AL},
+	{0x111C5, 0x111C6, LB_BA},
+	{0x111C7, 0x111C7, LB_AL},
+	{0x111C8, 0x111C8, LB_BA},
+	{0x111C9, 0x111CC, LB_CM},
+	{0x111CD, 0x111CD, LB_AL},
+	{0x111CE, 0x111CF, LB_CM},
+	{0x111D0, 0x111D9, LB_NU},
+	{0x111DA, 0x111DA, LB_AL},
+	{0x111DB, 0x111DB, LB_BB},
+	{0x111DC, 0x111DC, LB_AL},
+	{0x111DD, 0x111DF, LB_BA},
+	{0x111E1, 0x111F4, LB_AL},
+	{0x11200, 0x11211, LB_AL},
+	{0x11213, 0x1122B, LB_AL},
+	{0x1122C, 0x11237, LB_CM},
+	{0x11238, 0x11239, LB_BA},
+	{0x1123A, 0x1123A, LB_AL},
+	{0x1123B, 0x1123C, LB_BA},
+	{0x1123D, 0x1123D, LB_AL},
+	{0x1123E, 0x1123E, LB_CM},
+	{0x11280, 0x11286, LB_AL},
+	{0x11288, 0x11288, LB_AL},
+	{0x1128A, 0x1128D, LB_AL},
+	{0x1128F, 0x1129D, LB_AL},
+	{0x1129F, 0x112A8, LB_AL},
+	{0x112A9, 0x112A9, LB_BA},
+	{0x112B0, 0x112DE, LB_AL},
+	{0x112DF, 0x112EA, LB_CM},
+	{0x112F0, 0x112F9, LB_NU},
+	{0x11300, 0x11303, LB_CM},
+	{0x11305, 0x1130C, LB_AL},
+	{0x1130F, 0x11310, LB_AL},
+	{0x11313, 0x11328, LB_AL},
+	{0x1132A, 0x11330, LB_AL},
+	{0x11332, 0x11333, LB_AL},
+	{0x11335, 0x11339, LB_AL},
+	{0x1133B, 0x1133C, LB_CM},
+	{0x1133D, 0x1133D, LB_AL},
+	{0x1133E, 0x11344, LB_CM},
+	{0x11347, 0x11348, LB_CM},
+	{0x1134B, 0x1134D, LB_CM},
+	{0x11350, 0x11350, LB_AL},
+	{0x11357, 0x11357, LB_CM},
+	{0x1135D, 0x11361, LB_AL},
+	{0x11362, 0x11363, LB_CM},
+	{0x11366, 0x1136C, LB_CM},
+	{0x11370, 0x11374, LB_CM},
+	{0x11400, 0x11434, LB_AL},
+	{0x11435, 0x11446, LB_CM},
+	{0x11447, 0x1144A, LB_AL},
+	{0x1144B, 0x1144E, LB_BA},
+	{0x1144F, 0x1144F, LB_AL},
+	{0x11450, 0x11459, LB_NU},
+	{0x1145A, 0x1145B, LB_BA},
+	{0x1145D, 0x1145D, LB_AL},
+	{0x1145E, 0x1145E, LB_CM},
+	{0x1145F, 0x11461, LB_AL},
+	{0x11480, 0x114AF, LB_AL},
+	{0x114B0, 0x114C3, LB_CM},
+	{0x114C4, 0x114C7, LB_AL},
+	{0x114D0, 0x114D9, LB_NU},
+	{0x11580, 0x115AE, LB_AL},
+	{0x115AF, 0x115B5, LB_CM},
+	{0x115B8, 0x115C0, LB_CM},
+	{0x115C1, 0x115C1, LB_BB},
+	{0x115C2, 0x115C3, LB_BA},
+	{0x115C4, 0x115C5, LB_EX},
+	{0x115C6, 0x115C8, LB_AL},
+	{0x115C9, 0x115D7, LB_BA},
+	{0x115D8, 0x115DB, LB_AL},
+	{0x115DC, 0x115DD, LB_CM},
+	{0x11600, 0x1162F, LB_AL},
+	{0x11630, 0x11640, LB_CM},
+	{0x11641, 0x11642, LB_BA},
+	{0x11643, 0x11644, LB_AL},
+	{0x11650, 0x11659, LB_NU},
+	{0x11660, 0x1166C, LB_BB},
+	{0x11680, 0x116AA, LB_AL},
+	{0x116AB, 0x116B7, LB_CM},
+	{0x116B8, 0x116B9, LB_AL},
+	{0x116C0, 0x116C9, LB_NU},
+	{0x11700, 0x1171A, LB_SA},
+	{0x1171D, 0x1172B, LB_SA},
+	{0x11730, 0x11739, LB_NU},
+	{0x1173A, 0x1173B, LB_SA},
+	{0x1173C, 0x1173E, LB_BA},
+	{0x1173F, 0x11746, LB_SA},
+	{0x11800, 0x1182B, LB_AL},
+	{0x1182C, 0x1183A, LB_CM},
+	{0x1183B, 0x1183B, LB_AL},
+	{0x118A0, 0x118DF, LB_AL},
+	{0x118E0, 0x118E9, LB_NU},
+	{0x118EA, 0x118F2, LB_AL},
+	{0x118FF, 0x11906, LB_AL},
+	{0x11909, 0x11909, LB_AL},
+	{0x1190C, 0x11913, LB_AL},
+	{0x11915, 0x11916, LB_AL},
+	{0x11918, 0x1192F, LB_AL},
+	{0x11930, 0x11935, LB_CM},
+	{0x11937, 0x11938, LB_CM},
+	{0x1193B, 0x1193E, LB_CM},
+	{0x1193F, 0x1193F, LB_AL},
+	{0x11940, 0x11940, LB_CM},
+	{0x11941, 0x11941, LB_AL},
+	{0x11942, 0x11943, LB_CM},
+	{0x11944, 0x11946, LB_BA},
+	{0x11950, 0x11959, LB_NU},
+	{0x119A0, 0x119A7, LB_AL},
+	{0x119AA, 0x119D0, LB_AL},
+	{0x119D1, 0x119D7, LB_CM},
+	{0x119DA, 0x119E0, LB_CM},
+	{0x119E1, 0x119E1, LB_AL},
+	{0x119E2, 0x119E2, LB_BB},
+	{0x119E3, 0x119E3, LB_AL},
+	{0x119E4, 0x119E4, LB_CM},
+	{0x11A00, 0x11A00, LB_AL},
+	{0x11A01, 0x11A0A, LB_CM},
+	{0x11A0B, 0x11A32, LB_AL},
+	{0x11A33, 0x11A39, LB_CM},
+	{0x11A3A, 0x11A3A, LB_AL},
+	{0x11A3B, 0x11A3E, LB_CM},
+	{0x11A3F, 0x11A3F, LB_BB},
+	{0x11A40, 0x11A40, LB_AL},
+	{0x11A41, 0x11A44, LB_BA},
+	{0x11A45, 0x11A45, LB_BB},
+	{0x11A46, 0x11A46, LB_AL},
+	{0x11A47, 0x11A47, LB_CM},
+	{0x11A50, 0x11A50, LB_AL},
+	{0x11A51, 0x11A5B, LB_CM},
+	{0x11A5C, 0x11A89, LB_AL},
+	{0x11A8A, 0x11A99, LB_CM},
+	{0x11A9A, 0x11A9C, LB_BA},
+	{0x11A9D, 0x11A9D, LB_AL},
+	{0x11A9E, 0x11AA0, LB_BB},
+	{0x11AA1, 0x11AA2, LB_BA},
+	{0x11AB0, 0x11AF8, LB_AL},
+	{0x11C00, 0x11C08, LB_AL},
+	{0x11C0A, 0x11C2E, LB_AL},
+	{0x11C2F, 0x11C36, LB_CM},
+	{0x11C38, 0x11C3F, LB_CM},
+	{0x11C40, 0x11C40, LB_AL},
+	{0x11C41, 0x11C45, LB_BA},
+	{0x11C50, 0x11C59, LB_NU},
+	{0x11C5A, 0x11C6C, LB_AL},
+	{0x11C70, 0x11C70, LB_BB},
+	{0x11C71, 0x11C71, LB_EX},
+	{0x11C72, 0x11C8F, LB_AL},
+	{0x11C92, 0x11CA7, LB_CM},
+	{0x11CA9, 0x11CB6, LB_CM},
+	{0x11D00, 0x11D06, LB_AL},
+	{0x11D08, 0x11D09, LB_AL},
+	{0x11D0B, 0x11D30, LB_AL},
+	{0x11D31, 0x11D36, LB_CM},
+	{0x11D3A, 0x11D3A, LB_CM},
+	{0x11D3C, 0x11D3D, LB_CM},
+	{0x11D3F, 0x11D45, LB_CM},
+	{0x11D46, 0x11D46, LB_AL},
+	{0x11D47, 0x11D47, LB_CM},
+	{0x11D50, 0x11D59, LB_NU},
+	{0x11D60, 0x11D65, LB_AL},
+	{0x11D67, 0x11D68, LB_AL},
+	{0x11D6A, 0x11D89, LB_AL},
+	{0x11D8A, 0x11D8E, LB_CM},
+	{0x11D90, 0x11D91, LB_CM},
+	{0x11D93, 0x11D97, LB_CM},
+	{0x11D98, 0x11D98, LB_AL},
+	{0x11DA0, 0x11DA9, LB_NU},
+	{0x11EE0, 0x11EF2, LB_AL},
+	{0x11EF3, 0x11EF6, LB_CM},
+	{0x11EF7, 0x11EF8, LB_AL},
+	{0x11FB0, 0x11FB0, LB_AL},
+	{0x11FC0, 0x11FDC, LB_AL},
+	{0x11FDD, 0x11FE0, LB_PO},
+	{0x11FE1, 0x11FF1, LB_AL},
+	{0x11FFF, 0x11FFF, LB_BA},
+	{0x12000, 0x12399, LB_AL},
+	{0x12400, 0x1246E, LB_AL},
+	{0x12470, 0x12474, LB_BA},
+	{0x12480, 0x12543, LB_AL},
+	{0x12F90, 0x12FF2, LB_AL},
+	{0x13000, 0x13257, LB_AL},
+	{0x13258, 0x1325A, LB_OP},
+	{0x1325B, 0x1325D, LB_CL},
+	{0x1325E, 0x13281, LB_AL},
+	{0x13282, 0x13282, LB_CL},
+	{0x13283, 0x13285, LB_AL},
+	{0x13286, 0x13286, LB_OP},
+	{0x13287, 0x13287, LB_CL},
+	{0x13288, 0x13288, LB_OP},
+	{0x13289, 0x13289, LB_CL},
+	{0x1328A, 0x13378, LB_AL},
+	{0x13379, 0x13379, LB_OP},
+	{0x1337A, 0x1337B, LB_CL},
+	{0x1337C, 0x1342E, LB_AL},
+	{0x13430, 0x13436, LB_GL},
+	{0x13437, 0x13437, LB_OP},
+	{0x13438, 0x13438, LB_CL},
+	{0x14400, 0x145CD, LB_AL},
+	{0x145CE, 0x145CE, LB_OP},
+	{0x145CF, 0x145CF, LB_CL},
+	{0x145D0, 0x14646, LB_AL},
+	{0x16800, 0x16A38, LB_AL},
+	{0x16A40, 0x16A5E, LB_AL},
+	{0x16A60, 0x16A69, LB_NU},
+	{0x16A6E, 0x16A6F, LB_BA},
+	{0x16A70, 0x16ABE, LB_AL},
+	{0x16AC0, 0x16AC9, LB_NU},
+	{0x16AD0, 0x16AED, LB_AL},
+	{0x16AF0, 0x16AF4, LB_CM},
+	{0x16AF5, 0x16AF5, LB_BA},
+	{0x16B00, 0x16B2F, LB_AL},
+	{0x16B30, 0x16B36, LB_CM},
+	{0x16B37, 0x16B39, LB_BA},
+	{0x16B3A, 0x16B43, LB_AL},
+	{0x16B44, 0x16B44, LB_BA},
+	{0x16B45, 0x16B45, LB_AL},
+	{0x16B50, 0x16B59, LB_NU},
+	{0x16B5B, 0x16B61, LB_AL},
+	{0x16B63, 0x16B77, LB_AL},
+	{0x16B7D, 0x16B8F, LB_AL},
+	{0x16E40, 0x16E96, LB_AL},
+	{0x16E97, 0x16E98, LB_BA},
+	{0x16E99, 0x16E9A, LB_AL},
+	{0x16F00, 0x16F4A, LB_AL},
+	{0x16F4F, 0x16F4F, LB_CM},
+	{0x16F50, 0x16F50, LB_AL},
+	{0x16F51, 0x16F87, LB_CM},
+	{0x16F8F, 0x16F92, LB_CM},
+	{0x16F93, 0x16F9F, LB_AL},
+	{0x16FE0, 0x16FE3, LB_NS},
+	{0x16FE4, 0x16FE4, LB_GL},
+	{0x16FF0, 0x16FF1, LB_CM},
+	{0x17000, 0x187F7, LB_ID},
+	{0x18800, 0x18AFF, LB_ID},
+	{0x18B00, 0x18CD5, LB_AL},
+	{0x18D00, 0x18D08, LB_ID},
+	{0x1AFF0, 0x1AFF3, LB_AL},
+	{0x1AFF5, 0x1AFFB, LB_AL},
+	{0x1AFFD, 0x1AFFE, LB_AL},
+	{0x1B000, 0x1B122, LB_ID},
+	{0x1B150, 0x1B152, LB_CJ},
+	{0x1B164, 0x1B167, LB_CJ},
+	{0x1B170, 0x1B2FB, LB_ID},
+	{0x1BC00, 0x1BC6A, LB_AL},
+	{0x1BC70, 0x1BC7C, LB_AL},
+	{0x1BC80, 0x1BC88, LB_AL},
+	{0x1BC90, 0x1BC99, LB_AL},
+	{0x1BC9C, 0x1BC9C, LB_AL},
+	{0x1BC9D, 0x1BC9E, LB_CM},
+	{0x1BC9F, 0x1BC9F, LB_BA},
+	{0x1BCA0, 0x1BCA3, LB_CM},
+	{0x1CF00, 0x1CF2D, LB_CM},
+	{0x1CF30, 0x1CF46, LB_CM},
+	{0x1CF50, 0x1CFC3, LB_AL},
+	{0x1D000, 0x1D0F5, LB_AL},
+	{0x1D100, 0x1D126, LB_AL},
+	{0x1D129, 0x1D164, LB_AL},
+	{0x1D165, 0x1D169, LB_CM},
+	{0x1D16A, 0x1D16C, LB_AL},
+	{0x1D16D, 0x1D182, LB_CM},
+	{0x1D183, 0x1D184, LB_AL},
+	{0x1D185, 0x1D18B, LB_CM},
+	{0x1D18C, 0x1D1A9, LB_AL},
+	{0x1D1AA, 0x1D1AD, LB_CM},
+	{0x1D1AE, 0x1D1EA, LB_AL},
+	{0x1D200, 0x1D241, LB_AL},
+	{0x1D242, 0x1D244, LB_CM},
+	{0x1D245, 0x1D245, LB_AL},
+	{0x1D2E0, 0x1D2F3, LB_AL},
+	{0x1D300, 0x1D356, LB_AL},
+	{0x1D360, 0x1D378, LB_AL},
+	{0x1D400, 0x1D454, LB_AL},
+	{0x1D456, 0x1D49C, LB_AL},
+	{0x1D49E, 0x1D49F, LB_AL},
+	{0x1D4A2, 0x1D4A2, LB_AL},
+	{0x1D4A5, 0x1D4A6, LB_AL},
+	{0x1D4A9, 0x1D4AC, LB_AL},
+	{0x1D4AE, 0x1D4B9, LB_AL},
+	{0x1D4BB, 0x1D4BB, LB_AL},
+	{0x1D4BD, 0x1D4C3, LB_AL},
+	{0x1D4C5, 0x1D505, LB_AL},
+	{0x1D507, 0x1D50A, LB_AL},
+	{0x1D50D, 0x1D514, LB_AL},
+	{0x1D516, 0x1D51C, LB_AL},
+	{0x1D51E, 0x1D539, LB_AL},
+	{0x1D53B, 0x1D53E, LB_AL},
+	{0x1D540, 0x1D544, LB_AL},
+	{0x1D546, 0x1D546, LB_AL},
+	{0x1D54A, 0x1D550, LB_AL},
+	{0x1D552, 0x1D6A5, LB_AL},
+	{0x1D6A8, 0x1D7CB, LB_AL},
+	{0x1D7CE, 0x1D7FF, LB_NU},
+	{0x1D800, 0x1D9FF, LB_AL},
+	{0x1DA00, 0x1DA36, LB_CM},
+	{0x1DA37, 0x1DA3A, LB_AL},
+	{0x1DA3B, 0x1DA6C, LB_CM},
+	{0x1DA6D, 0x1DA74, LB_AL},
+	{0x1DA75, 0x1DA75, LB_CM},
+	{0x1DA76, 0x1DA83, LB_AL},
+	{0x1DA84, 0x1DA84, LB_CM},
+	{0x1DA85, 0x1DA86, LB_AL},
+	{0x1DA87, 0x1DA8A, LB_BA},
+	{0x1DA8B, 0x1DA8B, LB_AL},
+	{0x1DA9B, 0x1DA9F, LB_CM},
+	{0x1DAA1, 0x1DAAF, LB_CM},
+	{0x1DF00, 0x1DF1E, LB_AL},
+	{0x1E000, 0x1E006, LB_CM},
+	{0x1E008, 0x1E018, LB_CM},
+	{0x1E01B, 0x1E021, LB_CM},
+	{0x1E023, 0x1E024, LB_CM},
+	{0x1E026, 0x1E02A, LB_CM},
+	{0x1E100, 0x1E12C, LB_AL},
+	{0x1E130, 0x1E136, LB_CM},
+	{0x1E137, 0x1E13D, LB_AL},
+	{0x1E140, 0x1E149, LB_NU},
+	{0x1E14E, 0x1E14F, LB_AL},
+	{0x1E290, 0x1E2AD, LB_AL},
+	{0x1E2AE, 0x1E2AE, LB_CM},
+	{0x1E2C0, 0x1E2EB, LB_AL},
+	{0x1E2EC, 0x1E2EF, LB_CM},
+	{0x1E2F0, 0x1E2F9, LB_NU},
+	{0x1E2FF, 0x1E2FF, LB_PR},
+	{0x1E7E0, 0x1E7E6, LB_AL},
+	{0x1E7E8, 0x1E7EB, LB_AL},
+	{0x1E7ED, 0x1E7EE, LB_AL},
+	{0x1E7F0, 0x1E7FE, LB_AL},
+	{0x1E800, 0x1E8C4, LB_AL},
+	{0x1E8C7, 0x1E8CF, LB_AL},
+	{0x1E8D0, 0x1E8D6, LB_CM},
+	{0x1E900, 0x1E943, LB_AL},
+	{0x1E944, 0x1E94A, LB_CM},
+	{0x1E94B, 0x1E94B, LB_AL},
+	{0x1E950, 0x1E959, LB_NU},
+	{0x1E95E, 0x1E95F, LB_OP},
+	{0x1EC71, 0x1ECAB, LB_AL},
+	{0x1ECAC, 0x1ECAC, LB_PO},
+	{0x1ECAD, 0x1ECAF, LB_AL},
+	{0x1ECB0, 0x1ECB0, LB_PO},
+	{0x1ECB1, 0x1ECB4, LB_AL},
+	{0x1ED01, 0x1ED3D, LB_AL},
+	{0x1EE00, 0x1EE03, LB_AL},
+	{0x1EE05, 0x1EE1F, LB_AL},
+	{0x1EE21, 0x1EE22, LB_AL},
+	{0x1EE24, 0x1EE24, LB_AL},
+	{0x1EE27, 0x1EE27, LB_AL},
+	{0x1EE29, 0x1EE32, LB_AL},
+	{0x1EE34, 0x1EE37, LB_AL},
+	{0x1EE39, 0x1EE39, LB_AL},
+	{0x1EE3B, 0x1EE3B, LB_AL},
+	{0x1EE42, 0x1EE42, LB_AL},
+	{0x1EE47, 0x1EE47, LB_AL},
+	{0x1EE49, 0x1EE49, LB_AL},
+	{0x1EE4B, 0x1EE4B, LB_AL},
+	{0x1EE4D, 0x1EE4F, LB_AL},
+	{0x1EE51, 0x1EE52, LB_AL},
+	{0x1EE54, 0x1EE54, LB_AL},
+	{0x1EE57, 0x1EE57, LB_AL},
+	{0x1EE59, 0x1EE59, LB_AL},
+	{0x1EE5B, 0x1EE5B, LB_AL},
+	{0x1EE5D, 0x1EE5D, LB_AL},
+	{0x1EE5F, 0x1EE5F, LB_AL},
+	{0x1EE61, 0x1EE62, LB_AL},
+	{0x1EE64, 0x1EE64, LB_AL},
+	{0x1EE67, 0x1EE6A, LB_AL},
+	{0x1EE6C, 0x1EE72, LB_AL},
+	{0x1EE74, 0x1EE77, LB_AL},
+	{0x1EE79, 0x1EE7C, LB_AL},
+	{0x1EE7E, 0x1EE7E, LB_AL},
+	{0x1EE80, 0x1EE89, LB_AL},
+	{0x1EE8B, 0x1EE9B, LB_AL},
+	{0x1EEA1, 0x1EEA3, LB_AL},
+	{0x1EEA5, 0x1EEA9, LB_AL},
+	{0x1EEAB, 0x1EEBB, LB_AL},
+	{0x1EEF0, 0x1EEF1, LB_AL},
+	{0x1F000, 0x1F0FF, LB_ID},
+	{0x1F100, 0x1F10C, LB_AI},
+	{0x1F10D, 0x1F10F, LB_ID},
+	{0x1F110, 0x1F12D, LB_AI},
+	{0x1F12E, 0x1F12F, LB_AL},
+	{0x1F130, 0x1F169, LB_AI},
+	{0x1F16A, 0x1F16C, LB_AL},
+	{0x1F16D, 0x1F16F, LB_ID},
+	{0x1F170, 0x1F1AC, LB_AI},
+	{0x1F1AD, 0x1F1E5, LB_ID},
+	{0x1F1E6, 0x1F1FF, LB_RI},
+	{0x1F200, 0x1F384, LB_ID},
+	{0x1F385, 0x1F385, LB_EB},
+	{0x1F386, 0x1F39B, LB_ID},
+	{0x1F39C, 0x1F39D, LB_AL},
+	{0x1F39E, 0x1F3B4, LB_ID},
+	{0x1F3B5, 0x1F3B6, LB_AL},
+	{0x1F3B7, 0x1F3BB, LB_ID},
+	{0x1F3BC, 0x1F3BC, LB_AL},
+	{0x1F3BD, 0x1F3C1, LB_ID},
+	{0x1F3C2, 0x1F3C4, LB_EB},
+	{0x1F3C5, 0x1F3C6, LB_ID},
+	{0x1F3C7, 0x1F3C7, LB_EB},
+	{0x1F3C8, 0x1F3C9, LB_ID},
+	{0x1F3CA, 0x1F3CC, LB_EB},
+	{0x1F3CD, 0x1F3FA, LB_ID},
+	{0x1F3FB, 0x1F3FF, LB_EM},
+	{0x1F400, 0x1F441, LB_ID},
+	{0x1F442, 0x1F443, LB_EB},
+	{0x1F444, 0x1F445, LB_ID},
+	{0x1F446, 0x1F450, LB_EB},
+	{0x1F451, 0x1F465, LB_ID},
+	{0x1F466, 0x1F478, LB_EB},
+	{0x1F479, 0x1F47B, LB_ID},
+	{0x1F47C, 0x1F47C, LB_EB},
+	{0x1F47D, 0x1F480, LB_ID},
+	{0x1F481, 0x1F483, LB_EB},
+	{0x1F484, 0x1F484, LB_ID},
+	{0x1F485, 0x1F487, LB_EB},
+	{0x1F488, 0x1F48E, LB_ID},
+	{0x1F48F, 0x1F48F, LB_EB},
+	{0x1F490, 0x1F490, LB_ID},
+	{0x1F491, 0x1F491, LB_EB},
+	{0x1F492, 0x1F49F, LB_ID},
+	{0x1F4A0, 0x1F4A0, LB_AL},
+	{0x1F4A1, 0x1F4A1, LB_ID},
+	{0x1F4A2, 0x1F4A2, LB_AL},
+	{0x1F4A3, 0x1F4A3, LB_ID},
+	{0x1F4A4, 0x1F4A4, LB_AL},
+	{0x1F4A5, 0x1F4A9, LB_ID},
+	{0x1F4AA, 0x1F4AA, LB_EB},
+	{0x1F4AB, 0x1F4AE, LB_ID},
+	{0x1F4AF, 0x1F4AF, LB_AL},
+	{0x1F4B0, 0x1F4B0, LB_ID},
+	{0x1F4B1, 0x1F4B2, LB_AL},
+	{0x1F4B3, 0x1F4FF, LB_ID},
+	{0x1F500, 0x1F506, LB_AL},
+	{0x1F507, 0x1F516, LB_ID},
+	{0x1F517, 0x1F524, LB_AL},
+	{0x1F525, 0x1F531, LB_ID},
+	{0x1F532, 0x1F549, LB_AL},
+	{0x1F54A, 0x1F573, LB_ID},
+	{0x1F574, 0x1F575, LB_EB},
+	{0x1F576, 0x1F579, LB_ID},
+	{0x1F57A, 0x1F57A, LB_EB},
+	{0x1F57B, 0x1F58F, LB_ID},
+	{0x1F590, 0x1F590, LB_EB},
+	{0x1F591, 0x1F594, LB_ID},
+	{0x1F595, 0x1F596, LB_EB},
+	{0x1F597, 0x1F5D3, LB_ID},
+	{0x1F5D4, 0x1F5DB, LB_AL},
+	{0x1F5DC, 0x1F5F3, LB_ID},
+	{0x1F5F4, 0x1F5F9, LB_AL},
+	{0x1F5FA, 0x1F644, LB_ID},
+	{0x1F645, 0x1F647, LB_EB},
+	{0x1F648, 0x1F64A, LB_ID},
+	{0x1F64B, 0x1F64F, LB_EB},
+	{0x1F650, 0x1F675, LB_AL},
+	{0x1F676, 0x1F678, LB_QU},
+	{0x1F679, 0x1F67B, LB_NS},
+	{0x1F67C, 0x1F67F, LB_AL},
+	{0x1F680, 0x1F6A2, LB_ID},
+	{0x1F6A3, 0x1F6A3, LB_EB},
+	{0x1F6A4, 0x1F6B3, LB_ID},
+	{0x1F6B4, 0x1F6B6, LB_EB},
+	{0x1F6B7, 0x1F6BF, LB_ID},
+	{0x1F6C0, 0x1F6C0, LB_EB},
+	{0x1F6C1, 0x1F6CB, LB_ID},
+	{0x1F6CC, 0x1F6CC, LB_EB},
+	{0x1F6CD, 0x1F6FF, LB_ID},
+	{0x1F700, 0x1F773, LB_AL},
+	{0x1F774, 0x1F77F, LB_ID},
+	{0x1F780, 0x1F7D4, LB_AL},
+	{0x1F7D5, 0x1F7FF, LB_ID},
+	{0x1F800, 0x1F80B, LB_AL},
+	{0x1F80C, 0x1F80F, LB_ID},
+	{0x1F810, 0x1F847, LB_AL},
+	{0x1F848, 0x1F84F, LB_ID},
+	{0x1F850, 0x1F859, LB_AL},
+	{0x1F85A, 0x1F85F, LB_ID},
+	{0x1F860, 0x1F887, LB_AL},
+	{0x1F888, 0x1F88F, LB_ID},
+	{0x1F890, 0x1F8AD, LB_AL},
+	{0x1F8AE, 0x1F8FF, LB_ID},
+	{0x1F900, 0x1F90B, LB_AL},
+	{0x1F90C, 0x1F90C, LB_EB},
+	{0x1F90D, 0x1F90E, LB_ID},
+	{0x1F90F, 0x1F90F, LB_EB},
+	{0x1F910, 0x1F917, LB_ID},
+	{0x1F918, 0x1F91F, LB_EB},
+	{0x1F920, 0x1F925, LB_ID},
+	{0x1F926, 0x1F926, LB_EB},
+	{0x1F927, 0x1F92F, LB_ID},
+	{0x1F930, 0x1F939, LB_EB},
+	{0x1F93A, 0x1F93B, LB_ID},
+	{0x1F93C, 0x1F93E, LB_EB},
+	{0x1F93F, 0x1F976, LB_ID},
+	{0x1F977, 0x1F977, LB_EB},
+	{0x1F978, 0x1F9B4, LB_ID},
+	{0x1F9B5, 0x1F9B6, LB_EB},
+	{0x1F9B7, 0x1F9B7, LB_ID},
+	{0x1F9B8, 0x1F9B9, LB_EB},
+	{0x1F9BA, 0x1F9BA, LB_ID},
+	{0x1F9BB, 0x1F9BB, LB_EB},
+	{0x1F9BC, 0x1F9CC, LB_ID},
+	{0x1F9CD, 0x1F9CF, LB_EB},
+	{0x1F9D0, 0x1F9D0, LB_ID},
+	{0x1F9D1, 0x1F9DD, LB_EB},
+	{0x1F9DE, 0x1F9FF, LB_ID},
+	{0x1FA00, 0x1FA53, LB_AL},
+	{0x1FA54, 0x1FAC2, LB_ID},
+	{0x1FAC3, 0x1FAC5, LB_EB},
+	{0x1FAC6, 0x1FAEF, LB_ID},
+	{0x1FAF0, 0x1FAF6, LB_EB},
+	{0x1FAF7, 0x1FAFF, LB_ID},
+	{0x1FB00, 0x1FB92, LB_AL},
+	{0x1FB94, 0x1FBCA, LB_AL},
+	{0x1FBF0, 0x1FBF9, LB_NU},
+	{0x1FC00, 0x1FFFD, LB_ID},
+	{0x20000, 0x2FFFD, LB_ID},
+	{0x30000, 0x3FFFD, LB_ID},
+	{0xE0001, 0xE0001, LB_CM},
+	{0xE0020, 0xE007F, LB_CM},
+	{0xE0100, 0xE01EF, LB_CM},
+}
+
+// WIDE_OPENINGS are the opening punctuation of the East Asian width F, W or H
+var WIDE_OPENINGS = []classRange{
+	{0x2329, 0x2329, LB_OP},
+	{0x3008, 0x3008, LB_OP},
+	{0x300A, 0x300A, LB_OP},
+	{0x300C, 0x300C, LB_OP},
+	{0x300E, 0x300E, LB_OP},
+	{0x3010, 0x3010, LB_OP},
+	{0x3014, 0x3014, LB_OP},
+	{0x3016, 0x3016, LB_OP},
+	{0x3018, 0x3018, LB_OP},
+	{0x301A, 0x301A, LB_OP},
+	{0x301D, 0x301D, LB_OP},
+	{0xFE17, 0xFE17, LB_OP},
+	{0xFE35, 0xFE35, LB_OP},
+	{0xFE37, 0xFE37, LB_OP},
+	{0xFE39, 0xFE39, LB_OP},
+	{0xFE3B, 0xFE3B, LB_OP},
+	{0xFE3D, 0xFE3D, LB_OP},
+	{0xFE3F, 0xFE3F, LB_OP},
+	{0xFE41, 0xFE41, LB_OP},
+	{0xFE43, 0xFE43, LB_OP},
+	{0xFE47, 0xFE47, LB_OP},
+	{0xFE59, 0xFE59, LB_OP},
+	{0xFE5B, 0xFE5B, LB_OP},
+	{0xFE5D, 0xFE5D, LB_OP},
+	{0xFF08, 0xFF08, LB_OP},
+	{0xFF3B, 0xFF3B, LB_OP},
+	{0xFF5B, 0xFF5B, LB_OP},
+	{0xFF5F, 0xFF5F, LB_OP},
+	{0xFF62, 0xFF62, LB_OP},
+}
+
+// GRAPHEME_CLASSES are the Grapheme_Cluster_Break property of the characters, OTHER if none
+var GRAPHEME_CLASSES = []classRange{
+	{0x0000, 0x0009, GB_CONTROL},
+	{0x000A, 0x000A, GB_LF},
+	{0x000B, 0x000C, GB_CONTROL},
+	{0x000D, 0x000D, GB_CR},
+	{0x000E, 0x001F, GB_CONTROL},
+	{0x007F, 0x009F, GB_CONTROL},
+	{0x00AD, 0x00AD, GB_CONTROL},
+	{0x0300, 0x036F, GB_EXTEND},
+	{0x0483, 0x0489, GB_EXTEND},
+	{0x0591, 0x05BD, GB_EXTEND},
+	{0x05BF, 0x05BF, GB_EXTEND},
+	{0x05C1, 0x05C2, GB_EXTEND},
+	{0x05C4, 0x05C5, GB_EXTEND},
+	{0x05C7, 0x05C7, GB_EXTEND},
+	{0x0600, 0x0605, GB_PREPEND},
+	{0x0610, 0x061A, GB_EXTEND},
+	{0x061C, 0x061C, GB_CONTROL},
+	{0x064B, 0x065F, GB_EXTEND},
+	{0x0670, 0x0670, GB_EXTEND},
+	{0x06D6, 0x06DC, GB_EXTEND},
+	{0x06DD, 0x06DD, GB_PREPEND},
+	{0x06DF, 0x06E4, GB_EXTEND},
+	{0x06E7, 0x06E8, GB_EXTEND},
+	{0x06EA, 0x06ED, GB_EXTEND},
+	{0x070F, 0x070F, GB_PREPEND},
+	{0x0711, 0x0711, GB_EXTEND},
+	{0x0730, 0x074A, GB_EXTEND},
+	{0x07A6, 0x07B0, GB_EXTEND},
+	{0x07EB, 0x07F3, GB_EXTEND},
+	{0x07FD, 0x07FD, GB_EXTEND},
+	{0x0816, 0x0819, GB_EXTEND},
+	{0x081B, 0x0823, GB_EXTEND},
+	{0x0825, 0x0827, GB_EXTEND},
+	{0x0829, 0x082D, GB_EXTEND},
+	{0x0859, 0x085B, GB_EXTEND},
+	{0x0890, 0x0891, GB_PREPEND},
+	{0x0898, 0x089F, GB_EXTEND},
+	{0x08CA, 0x08E1, GB_EXTEND},
+	{0x08E2, 0x08E2, GB_PREPEND},
+	{0x08E3, 0x0902, GB_EXTEND},
+	{0x0903, 0x0903, GB_SPACING_MARK},
+	{0x093A, 0x093A, GB_EXTEND},
+	{0x093B, 0x093B, GB_SPACING_MARK},
+	{0x093C, 0x093C, GB_EXTEND},
+	{0x093E, 0x0940, GB_SPACING_MARK},
+	{0x0941, 0x0948, GB_EXTEND},
+	{0x0949, 0x094C, GB_SPACING_MARK},
+	{0x094D, 0x094D, GB_EXTEND},
+	{0x094E, 0x094F, GB_SPACING_MARK},
+	{0x0951, 0x0957, GB_EXTEND},
+	{0x0962, 0x0963, GB_EXTEND},
+	{0x0981, 0x0981, GB_EXTEND},
+	{0x0982, 0x0983, GB_SPACING_MARK},
+	{0x09BC, 0x09BC, GB_EXTEND},
+	{0x09BE, 0x09BE, GB_EXTEND},
+	{0x09BF, 0x09C0, GB_SPACING_MARK},
+	{0x09C1, 0x09C4, GB_EXTEND},
+	{0x09C7, 0x09C8, GB_SPACING_MARK},
+	{0x09CB, 0x09CC, GB_SPACING_MARK},
+	{0x09CD, 0x09CD, GB_EXTEND},
+	{0x09D7, 0x09D7, GB_EXTEND},
+	{0x09E2, 0x09E3, GB_EXTEND},
+	{0x09FE, 0x09FE, GB_EXTEND},
+	{0x0A01, 0x0A02, GB_EXTEND},
+	{0x0A03, 0x0A03, GB_SPACING_MARK},
+	{0x0A3C, 0x0A3C, GB_EXTEND},
+	{0x0A3E, 0x0A40, GB_SPACING_MARK},
+	{0x0A41, 0x0A42, GB_EXTEND},
+	{0x0A47, 0x0A48, GB_EXTEND},
+	{0x0A4B, 0x0A4D, GB_EXTEND},
+	{0x0A51, 0x0A51, GB_EXTEND},
+	{0x0A70, 0x0A71, GB_EXTEND},
+	{0x0A75, 0x0A75, GB_EXTEND},
+	{0x0A81, 0x0A82, GB_EXTEND},
+	{0x0A83, 0x0A83, GB_SPACING_MARK},
+	{0x0ABC, 0x0ABC, GB_EXTEND},
+	{0x0ABE, 0x0AC0, GB_SPACING_MARK},
+	{0x0AC1, 0x0AC5, GB_EXTEND},
+	{0x0AC7, 0x0AC8, GB_EXTEND},
+	{0x0AC9, 0x0AC9, GB_SPACING_MARK},
+	{0x0ACB, 0x0ACC, GB_SPACING_MARK},
+	{0x0ACD, 0x0ACD, GB_EXTEND},
+	{0x0AE2, 0x0AE3, GB_EXTEND},
+	{0x0AFA, 0x0AFF, GB_EXTEND},
+	{0x0B01, 0x0B01, GB_EXTEND},
+	{0x0B02, 0x0B03, GB_SPACING_MARK},
+	{0x0B3C, 0x0B3C, GB_EXTEND},
+	{0x0B3E, 0x0B3F, GB_EXTEND},
+	{0x0B40, 0x0B40, GB_SPACING_MARK},
+	{0x0B41, 0x0B44, GB_EXTEND},
+	{0x0B47, 0x0B48, GB_SPACING_MARK},
+	{0x0B4B, 0x0B4C, GB_SPACING_MARK},
+	{0x0B4D, 0x0B4D, GB_EXTEND},
+	{0x0B55, 0x0B57, GB_EXTEND},
+	{0x0B62, 0x0B63, GB_EXTEND},
+	{0x0B82, 0x0B82, GB_EXTEND},
+	{0x0BBE, 0x0BBE, GB_EXTEND},
+	{0x0BBF, 0x0BBF, GB_SPACING_MARK},
+	{0x0BC0, 0x0BC0, GB_EXTEND},
+	{0x0BC1, 0x0BC2, GB_SPACING_MARK},
+	{0x0BC6, 0x0BC8, GB_SPACING_MARK},
+	{0x0BCA, 0x0BCC, GB_SPACING_MARK},
+	{0x0BCD, 0x0BCD, GB_EXTEND},
+	{0x0BD7, 0x0BD7, GB_EXTEND},
+	{0x0C00, 0x0C00, GB_EXTEND},
+	{0x0C01, 0x0C03, GB_SPACING_MARK},
+	{0x0C04, 0x0C04, GB_EXTEND},
+	{0x0C3C, 0x0C3C, GB_EXTEND},
+	{0x0C3E, 0x0C40, GB_EXTEND},
+	{0x0C41, 0x0C44, GB_SPACING_MARK},
+	{0x0C46, 0x0C48, GB_EXTEND},
+	{0x0C4A, 0x0C4D, GB_EXTEND},
+	{0x0C55, 0x0C56, GB_EXTEND},
+	{0x0C62, 0x0C63, GB_EXTEND},
+	{0x0C81, 0x0C81, GB_EXTEND},
+	{0x0C82, 0x0C83, GB_SPACING_MARK},
+	{0x0CBC, 0x0CBC, GB_EXTEND},
+	{0x0CBE, 0x0CBE, GB_SPACING_MARK},
+	{0x0CBF, 0x0CBF, GB_EXTEND},
+	{0x0CC0, 0x0CC1, GB_SPACING_MARK},
+	{0x0CC2, 0x0CC2, GB_EXTEND},
+	{0x0CC3, 0x0CC4, GB_SPACING_MARK},
+	{0x0CC6, 0x0CC6, GB_EXTEND},
+	{0x0CC7, 0x0CC8, GB_SPACING_MARK},
+	{0x0CCA, 0x0CCB, GB_SPACING_MARK},
+	{0x0CCC, 0x0CCD, GB_EXTEND},
+	{0x0CD5, 0x0CD6, GB_EXTEND},
+	{0x0CE2, 0x0CE3, GB_EXTEND},
+	{0x0D00, 0x0D01, GB_EXTEND},
+	{0x0D02, 0x0D03, GB_SPACING_MARK},
+	{0x0D3B, 0x0D3C, GB_EXTEND},
+	{0x0D3E, 0x0D3E, GB_EXTEND},
+	{0x0D3F, 0x0D40, GB_SPACING_MARK},
+	{0x0D41, 0x0D44, GB_EXTEND},
+	{0x0D46, 0x0D48, GB_SPACING_MARK},
+	{0x0D4A, 0x0D4C, GB_SPACING_MARK},
+	{0x0D4D, 0x0D4D, GB_EXTEND},
+	{0x0D4E, 0x0D4E, GB_PREPEND},
+	{0x0D57, 0x0D57, GB_EXTEND},
+	{0x0D62, 0x0D63, GB_EXTEND},
+	{0x0D81, 0x0D81, GB_EXTEND},
+	{0x0D82, 0x0D83, GB_SPACING_MARK},
+	{0x0DCA, 0x0DCA, GB_EXTEND},
+	{0x0DCF, 0x0DCF, GB_EXTEND},
+	{0x0DD0, 0x0DD1, GB_SPACING_MARK},
+	{0x0DD2, 0x0DD4, GB_EXTEND},
+	{0x0DD6, 0x0DD6, GB_EXTEND},
+	{0x0DD8, 0x0DDE, GB_SPACING_MARK},
+	{0x0DDF, 0x0DDF, GB_EXTEND},
+	{0x0DF2, 0x0DF3, GB_SPACING_MARK},
+	{0x0E31, 0x0E31, GB_EXTEND},
+	{0x0E33, 0x0E33, GB_SPACING_MARK},
+	{0x0E34, 0x0E3A, GB_EXTEND},
+	{0x0E47, 0x0E4E, GB_EXTEND},
+	{0x0EB1, 0x0EB1, GB_EXTEND},
+	{0x0EB3, 0x0EB3, GB_SPACING_MARK},
+	{0x0EB4, 0x0EBC, GB_EXTEND},
+	{0x0EC8, 0x0ECD, GB_EXTEND},
+	{0x0F18, 0x0F19, GB_EXTEND},
+	{0x0F35, 0x0F35, GB_EXTEND},
+	{0x0F37, 0x0F37, GB_EXTEND},
+	{0x0F39, 0x0F39, GB_EXTEND},
+	{0x0F3E, 0x0F3F, GB_SPACING_MARK},
+	{0x0F71, 0x0F7E, GB_EXTEND},
+	{0x0F7F, 0x0F7F, GB_SPACING_MARK},
+	{0x0F80, 0x0F84, GB_EXTEND},
+	{0x0F86, 0x0F87, GB_EXTEND},
+	{0x0F8D, 0x0F97, GB_EXTEND},
+	{0x0F99, 0x0FBC, GB_EXTEND},
+	{0x0FC6, 0x0FC6, GB_EXTEND},
+	{0x102D, 0x1030, GB_EXTEND},
+	{0x1031, 0x1031, GB_SPACING_MARK},
+	{0x1032, 0x1037, GB_EXTEND},
+	{0x1039, 0x103A, GB_EXTEND},
+	{0x103B, 0x103C, GB_SPACING_MARK},
+	{0x103D, 0x103E, GB_EXTEND},
+	{0x1056, 0x1057, GB_SPACING_MARK},
+	{0x1058, 0x1059, GB_EXTEND},
+	{0x105E, 0x1060, GB_EXTEND},
+	{0x1071, 0x1074, GB_EXTEND},
+	{0x1082, 0x1082, GB_EXTEND},
+	{0x1084, 0x1084, GB_SPACING_MARK},
+	{0x1085, 0x1086, GB_EXTEND},
+	{0x108D, 0x108D, GB_EXTEND},
+	{0x109D, 0x109D, GB_EXTEND},
+	{0x1100, 0x115F, GB_L},
+	{0x1160, 0x11A7, GB_V},
+	{0x11A8, 0x11FF, GB_T},
+	{0x135D, 0x135F, GB_EXTEND},
+	{0x1712, 0x1714, GB_EXTEND},
+	{0x1715, 0x1715, GB_SPACING_MARK},
+	{0x1732, 0x1733, GB_EXTEND},
+	{0x1734, 0x1734, GB_SPACING_MARK},
+	{0x1752, 0x1753, GB_EXTEND},
+	{0x1772, 0x1773, GB_EXTEND},
+	{0x17B4, 0x17B5, GB_EXTEND},
+	{0x17B6, 0x17B6, GB_SPACING_MARK},
+	{0x17B7, 0x17BD, GB_EXTEND},
+	{0x17BE, 0x17C5, GB_SPACING_MARK},
+	{0x17C6, 0x17C6, GB_EXTEND},
+	{0x17C7, 0x17C8, GB_SPACING_MARK},
+	{0x17C9, 0x17D3, GB_EXTEND},
+	{0x17DD, 0x17DD, GB_EXTEND},
+	{0x180B, 0x180D, GB_EXTEND},
+	{0x180E, 0x180E, GB_CONTROL},
+	{0x180F, 0x180F, GB_EXTEND},
+	{0x1885, 0x1886, GB_EXTEND},
+	{0x18A9, 0x18A9, GB_EXTEND},
+	{0x1920, 0x1922, GB_EXTEND},
+	{0x1923, 0x1926, GB_SPACING_MARK},
+	{0x1927, 0x1928, GB_EXTEND},
+	{0x1929, 0x192B, GB_SPACING_MARK},
+	{0x1930, 0x1931, GB_SPACING_MARK},
+	{0x1932, 0x1932, GB_EXTEND},
+	{0x1933, 0x1938, GB_SPACING_MARK},
+	{0x1939, 0x193B, GB_EXTEND},
+	{0x1A17, 0x1A18, GB_EXTEND},
+	{0x1A19, 0x1A1A, GB_SPACING_MARK},
+	{0x1A1B, 0x1A1B, GB_EXTEND},
+	{0x1A55, 0x1A55, GB_SPACING_MARK},
+	{0x1A56, 0x1A56, GB_EXTEND},
+	{0x1A57, 0x1A57, GB_SPACING_MARK},
+	{0x1A58, 0x1A5E, GB_EXTEND},
+	{0x1A60, 0x1A60, GB_EXTEND},
+	{0x1A62, 0x1A62, GB_EXTEND},
+	{0x1A65, 0x1A6C, GB_EXTEND},
+	{0x1A6D, 0x1A72, GB_SPACING_MARK},
+	{0x1A73, 0x1A7C, GB_EXTEND},
+	{0x1A7F, 0x1A7F, GB_EXTEND},
+	{0x1AB0, 0x1ACE, GB_EXTEND},
+	{0x1B00, 0x1B03, GB_EXTEND},
+	{0x1B04, 0x1B04, GB_SPACING_MARK},
+	{0x1B34, 0x1B3A, GB_EXTEND},
+	{0x1B3B, 0x1B3B, GB_SPACING_MARK},
+	{0x1B3C, 0x1B3C, GB_EXTEND},
+	{0x1B3D, 0x1B41, GB_SPACING_MARK},
+	{0x1B42, 0x1B42, GB_EXTEND},
+	{0x1B43, 0x1B44, GB_SPACING_MARK},
+	{0x1B6B, 0x1B73, GB_EXTEND},
+	{0x1B80, 0x1B81, GB_EXTEND},
+	{0x1B82, 0x1B82, GB_SPACING_MARK},
+	{0x1BA1, 0x1BA1, GB_SPACING_MARK},
+	{0x1BA2, 0x1BA5, GB_EXTEND},
+	{0x1BA6, 0x1BA7, GB_SPACING_MARK},
+	{0x1BA8, 0x1BA9, GB_EXTEND},
+	{0x1BAA, 0x1BAA, GB_SPACING_MARK},
+	{0x1BAB, 0x1BAD, GB_EXTEND},
+	{0x1BE6, 0x1BE6, GB_EXTEND},
+	{0x1BE7, 0x1BE7, GB_SPACING_MARK},
+	{0x1BE8, 0x1BE9, GB_EXTEND},
+	{0x1BEA, 0x1BEC, GB_SPACING_MARK},
+	{0x1BED, 0x1BED, GB_EXTEND},
+	{0x1BEE, 0x1BEE, GB_SPACING_MARK},
+	{0x1BEF, 0x1BF1, GB_EXTEND},
+	{0x1BF2, 0x1BF3, GB_SPACING_MARK},
+	{0x1C24, 0x1C2B, GB_SPACING_MARK},
+	{0x1C2C, 0x1C33, GB_EXTEND},
+	{0x1C34, 0x1C35, GB_SPACING_MARK},
+	{0x1C36, 0x1C37, GB_EXTEND},
+	{0x1CD0, 0x1CD2, GB_EXTEND},
+	{0x1CD4, 0x1CE0, GB_EXTEND},
+	{0x1CE1, 0x1CE1, GB_SPACING_MARK},
+	{0x1CE2, 0x1CE8, GB_EXTEND},
+	{0x1CED, 0x1CED, GB_EXTEND},
+	{0x1CF4, 0x1CF4, GB_EXTEND},
+	{0x1CF7, 0x1CF7, GB_SPACING_MARK},
+	{0x1CF8, 0x1CF9, GB_EXTEND},
+	{0x1DC0, 0x1DFF, GB_EXTEND},
+	{0x200B, 0x200B, GB_CONTROL},
+	{0x200C, 0x200C, GB_EXTEND},
+	{0x200D, 0x200D, GB_ZWJ},
+	{0x200E, 0x200F, GB_CONTROL},
+	{0x2028, 0x202E, GB_CONTROL},
+	{0x2060, 0x206F, GB_CONTROL},
+	{0x20D0, 0x20F0, GB_EXTEND},
+	{0x2CEF, 0x2CF1, GB_EXTEND},
+	{0x2D7F, 0x2D7F, GB_EXTEND},
+	{0x2DE0, 0x2DFF, GB_EXTEND},
+	{0x302A, 0x302F, GB_EXTEND},
+	{0x3099, 0x309A, GB_EXTEND},
+	{0xA66F, 0xA672, GB_EXTEND},
+	{0xA674, 0xA67D, GB_EXTEND},
+	{0xA69E, 0xA69F, GB_EXTEND},
+	{0xA6F0, 0xA6F1, GB_EXTEND},
+	{0xA802, 0xA802, GB_EXTEND},
+	{0xA806, 0xA806, GB_EXTEND},
+	{0xA80B, 0xA80B, GB_EXTEND},
+	{0xA823, 0xA824, GB_SPACING_MARK},
+	{0xA825, 0xA826, GB_EXTEND},
+	{0xA827, 0xA827, GB_SPACING_MARK},
+	{0xA82C, 0xA82C, GB_EXTEND},
+	{0xA880, 0xA881, GB_SPACING_MARK},
+	{0xA8B4, 0xA8C3, GB_SPACING_MARK},
+	{0xA8C4, 0xA8C5, GB_EXTEND},
+	{0xA8E0, 0xA8F1, GB_EXTEND},
+	{0xA8FF, 0xA8FF, GB_EXTEND},
+	{0xA926, 0xA92D, GB_EXTEND},
+	{0xA947, 0xA951, GB_EXTEND},
+	{0xA952, 0xA953, GB_SPACING_MARK},
+	{0xA960, 0xA97C, GB_L},
+	{0xA980, 0xA982, GB_EXTEND},
+	{0xA983, 0xA983, GB_SPACING_MARK},
+	{0xA9B3, 0xA9B3, GB_EXTEND},
+	{0xA9B4, 0xA9B5, GB_SPACING_MARK},
+	{0xA9B6, 0xA9B9, GB_EXTEND},
+	{0xA9BA, 0xA9BB, GB_SPACING_MARK},
+	{0xA9BC, 0xA9BD, GB_EXTEND},
+	{0xA9BE, 0xA9C0, GB_SPACING_MARK},
+	{0xA9E5, 0xA9E5, GB_EXTEND},
+	{0xAA29, 0xAA2E, GB_EXTEND},
+	{0xAA2F, 0xAA30, GB_SPACING_MARK},
+	{0xAA31, 0xAA32, GB_EXTEND},
+	{0xAA33, 0xAA34, GB_SPACING_MARK},
+	{0xAA35, 0xAA36, GB_EXTEND},
+	{0xAA43, 0xAA43, GB_EXTEND},
+	{0xAA4C, 0xAA4C, GB_EXTEND},
+	{0xAA4D, 0xAA4D, GB_SPACING_MARK},
+	{0xAA7C, 0xAA7C, GB_EXTEND},
+	{0xAAB0, 0xAAB0, GB_EXTEND},
+	{0xAAB2, 0xAAB4, GB_EXTEND},
+	{0xAAB7, 0xAAB8, GB_EXTEND},
+	{0xAABE, 0xAABF, GB_EXTEND},
+	{0xAAC1, 0xAAC1, GB_EXTEND},
+	{0xAAEB, 0xAAEB, GB_SPACING_MARK},
+	{0xAAEC, 0xAAED, GB_EXTEND},
+	{0xAAEE, 0xAAEF, GB_SPACING_MARK},
+	{0xAAF5, 0xAAF5, GB_SPACING_MARK},
+	{0xAAF6, 0xAAF6, GB_EXTEND},
+	{0xABE3, 0xABE4, GB_SPACING_MARK},
+	{0xABE5, 0xABE5, GB_EXTEND},
+	{0xABE6, 0xABE7, GB_SPACING_MARK},
+	{0xABE8, 0xABE8, GB_EXTEND},
+	{0xABE9, 0xABEA, GB_SPACING_MARK},
+	{0xABEC, 0xABEC, GB_SPACING_MARK},
+	{0xABED, 0xABED, GB_EXTEND},
+	{0xAC00, 0xAC00, GB_LV},
+	{0xAC01, 0xAC1B, GB_LVT},
+	{0xAC1C, 0xAC1C, GB_LV},
+	{0xAC1D, 0xAC37, GB_LVT},
+	{0xAC38, 0xAC38, GB_LV},
+	{0xAC39, 0xAC53, GB_LVT},
+	{0xAC54, 0xAC54, GB_LV},
+	{0xAC55, 0xAC6F, GB_LVT},
+	{0xAC70, 0xAC70, GB_LV},
+	{0xAC71, 0xAC8B, GB_LVT},
+	{0xAC8C, 0xAC8C, GB_LV},
+	{0xAC8D, 0xACA7, GB_LVT},
+	{0xACA8, 0xACA8, GB_LV},
+	{0xACA9, 0xACC3, GB_LVT},
+	{0xACC4, 0xACC4, GB_LV},
+	{0xACC5, 0xACDF, GB_LVT},
+	{0xACE0, 0xACE0, GB_LV},
+	{0xACE1, 0xACFB, GB_LVT},
+	{0xACFC, 0xACFC, GB_LV},
+	{0xACFD, 0xAD17, GB_LVT},
+	{0xAD18, 0xAD18, GB_LV},
+	{0xAD19, 0xAD33, GB_LVT},
+	{0xAD34, 0xAD34, GB_LV},
+	{0xAD35, 0xAD4F, GB_LVT},
+	{0xAD50, 0xAD50, GB_LV},
+	{0xAD51, 0xAD6B, GB_LVT},
+	{0xAD6C, 0xAD6C, GB_LV},
+	{0xAD6D, 0xAD87, GB_LVT},
+	{0xAD88, 0xAD88, GB_LV},
+	{0xAD89, 0xADA3, GB_LVT},
+	{0xADA4, 0xADA4, GB_LV},
+	{0xADA5, 0xADBF, GB_LVT},
+	{0xADC0, 0xADC0, GB_LV},
+	{0xADC1, 0xADDB, GB_LVT},
+	{0xADDC, 0xADDC, GB_LV},
+	{0xADDD, 0xADF7, GB_LVT},
+	{0xADF8, 0xADF8, GB_LV},
+	{0xADF9, 0xAE13, GB_LVT},
+	{0xAE14, 0xAE14, GB_LV},
+	{0xAE15, 0xAE2F, GB_LVT},
+	{0xAE30, 0xAE30, GB_LV},
+	{0xAE31, 0xAE4B, GB_LVT},
+	{0xAE4C, 0xAE4C, GB_LV},
+	{0xAE4D, 0xAE67, GB_LVT},
+	{0xAE68, 0xAE68, GB_LV},
+	{0xAE69, 0xAE83, GB_LVT},
+	{0xAE84, 0xAE84, GB_LV},
+	{0xAE85, 0xAE9F, GB_LVT},
+	{0xAEA0, 0xAEA0, GB_LV},
+	{0xAEA1, 0xAEBB, GB_LVT},
+	{0xAEBC, 0xAEBC, GB_LV},
+	{0xAEBD, 0xAED7, GB_LVT},
+	{0xAED8, 0xAED8, GB_LV},
+	{0xAED9, 0xAEF3, GB_LVT},
+	{0xAEF4, 0xAEF4, GB_LV},
+	{0xAEF5, 0xAF0F, GB_LVT},
+	{0xAF10, 0xAF10, GB_LV},
+	{0xAF11, 0xAF2B, GB_LVT},
+	{0xAF2C, 0xAF2C, GB_LV},
+	{0xAF2D, 0xAF47, GB_LVT},
+	{0xAF48, 0xAF48, GB_LV},
+	{0xAF49, 0xAF63, GB_LVT},
+	{0xAF64, 0xAF64, GB_LV},
+	{0xAF65, 0xAF7F, GB_LVT},
+	{0xAF80, 0xAF80, GB_LV},
+	{0xAF81, 0xAF9B, GB_LVT},
+	{0xAF9C, 0xAF9C, GB_LV},
+	{0xAF9D, 0xAFB7, GB_LVT},
+	{0xAFB8, 0xAFB8, GB_LV},
+	{0xAFB9, 0xAFD3, GB_LVT},
+	{0xAFD4, 0xAFD4, GB_LV},
+	{0xAFD5, 0xAFEF, GB_LVT},
+	{0xAFF0, 0xAFF0, GB_LV},
+	{0xAFF1, 0xB00B, GB_LVT},
+	{0xB00C, 0xB00C, GB_LV},
+	{0xB00D, 0xB027, GB_LVT},
+	{0xB028, 0xB028, GB_LV},
+	{0xB029, 0xB043, GB_LVT},
+	{0xB044, 0xB044, GB_LV},
+	{0xB045, 0xB05F, GB_LVT},
+	{0xB060, 0xB060, GB_LV},
+	{0xB061, 0xB07B, GB_LVT},
+	{0xB07C, 0xB07C, GB_LV},
+	{0xB07D, 0xB097, GB_LVT},
+	{0xB098, 0xB098, GB_LV},
+	{0xB099, 0xB0B3, GB_LVT},
+	{0xB0B4, 0xB0B4, GB_LV},
+	{0xB0B5, 0xB0CF, GB_LVT},
+	{0xB0D0, 0xB0D0, GB_LV},
+	{0xB0D1, 0xB0EB, GB_LVT},
+	{0xB0EC, 0xB0EC, GB_LV},
+	{0xB0ED, 0xB107, GB_LVT},
+	{0xB108, 0xB108, GB_LV},
+	{0xB109, 0xB123, GB_LVT},
+	{0xB124, 0xB124, GB_LV},
+	{0xB125, 0xB13F, GB_LVT},
+	{0xB140, 0xB140, GB_LV},
+	{0xB141, 0xB15B, GB_LVT},
+	{0xB15C, 0xB15C, GB_LV},
+	{0xB15D, 0xB177, GB_LVT},
+	{0xB178, 0xB178, GB_LV},
+	{0xB179, 0xB193, GB_LVT},
+	{0xB194, 0xB194, GB_LV},
+	{0xB195, 0xB1AF, GB_LVT},
+	{0xB1B0, 0xB1B0, GB_LV},
+	{0xB1B1, 0xB1CB, GB_LVT},
+	{0xB1CC, 0xB1CC, GB_LV},
+	{0xB1CD, 0xB1E7, GB_LVT},
+	{0xB1E8, 0xB1E8, GB_LV},
+	{0xB1E9, 0xB203, GB_LVT},
+	{0xB204, 0xB204, GB_LV},
+	{0xB205, 0xB21F, GB_LVT},
+	{0xB220, 0xB220, GB_LV},
+	{0xB221, 0xB23B, GB_LVT},
+	{0xB23C, 0xB23C, GB_LV},
+	{0xB23D, 0xB257, GB_LVT},
+	{0xB258, 0xB258, GB_LV},
+	{0xB259, 0xB273, GB_LVT},
+	{0xB274, 0xB274, GB_LV},
+	{0xB275, 0xB28F, GB_LVT},
+	{0xB290, 0xB290, GB_LV},
+	{0xB291, 0xB2AB, GB_LVT},
+	{0xB2AC, 0xB2AC, GB_LV},
+	{0xB2AD, 0xB2C7, GB_LVT},
+	{0xB2C8, 0xB2C8, GB_LV},
+	{0xB2C9, 0xB2E3, GB_LVT},
+	{0xB2E4, 0xB2E4, GB_LV},
+	{0xB2E5, 0xB2FF, GB_LVT},
+	{0xB300, 0xB300, GB_LV},
+	{0xB301, 0xB31B, GB_LVT},
+	{0xB31C, 0xB31C, GB_LV},
+	{0xB31D, 0xB337, GB_LVT},
+	{0xB338, 0xB338, GB_LV},
+	{0xB339, 0xB353, GB_LVT},
+	{0xB354, 0xB354, GB_LV},
+	{0xB355, 0xB36F, GB_LVT},
+	{0xB370, 0xB370, GB_LV},
+	{0xB371, 0xB38B, GB_LVT},
+	{0xB38C, 0xB38C, GB_LV},
+	{0xB38D, 0xB3A7, GB_LVT},
+	{0xB3A8, 0xB3A8, GB_LV},
+	{0xB3A9, 0xB3C3, GB_LVT},
+	{0xB3C4, 0xB3C4, GB_LV},
+	{0xB3C5, 0xB3DF, GB_LVT},
+	{0xB3E0, 0xB3E0, GB_LV},
+	{0xB3E1, 0xB3FB, GB_LVT},
+	{0xB3FC, 0xB3FC, GB_LV},
+	{0xB3FD, 0xB417, GB_LVT},
+	{0xB418, 0xB418, GB_LV},
+	{0xB419, 0xB433, GB_LVT},
+	{0xB434, 0xB434, GB_LV},
+	{0xB435, 0xB44F, GB_LVT},
+	{0xB450, 0xB450, GB_LV},
+	{0xB451, 0xB46B, GB_LVT},
+	{0xB46C, 0xB46C, GB_LV},
+	{0xB46D, 0xB487, GB_LVT},
+	{0xB488, 0xB488, GB_LV},
+	{0xB489, 0xB4A3, GB_LVT},
+	{0xB4A4, 0xB4A4, GB_LV},
+	{0xB4A5, 0xB4BF, GB_LVT},
+	{0xB4C0, 0xB4C0, GB_LV},
+	{0xB4C1, 0xB4DB, GB_LVT},
+	{0xB4DC, 0xB4DC, GB_LV},
+	{0xB4DD, 0xB4F7, GB_LVT},
+	{0xB4F8, 0xB4F8, GB_LV},
+	{0xB4F9, 0xB513, GB_LVT},
+	{0xB514, 0xB514, GB_LV},
+	{0xB515, 0xB52F, GB_LVT},
+	{0xB530, 0xB530, GB_LV},
+	{0xB531, 0xB54B, GB_LVT},
+	{0xB54C, 0xB54C, GB_LV},
+	{0xB54D, 0xB567, GB_LVT},
+	{0xB568, 0xB568, GB_LV},
+	{0xB569, 0xB583, GB_LVT},
+	{0xB584, 0xB584, GB_LV},
+	{0xB585, 0xB59F, GB_LVT},
+	{0xB5A0, 0xB5A0, GB_LV},
+	{0xB5A1, 0xB5BB, GB_LVT},
+	{0xB5BC, 0xB5BC, GB_LV},
+	{0xB5BD, 0xB5D7, GB_LVT},
+	{0xB5D8, 0xB5D8, GB_LV},
+	{0xB5D9, 0xB5F3, GB_LVT},
+	{0xB5F4, 0xB5F4, GB_LV},
+	{0xB5F5, 0xB60F, GB_LVT},
+	{0xB610, 0xB610, GB_LV},
+	{0xB611, 0xB62B, GB_LVT},
+	{0xB62C, 0xB62C, GB_LV},
+	{0xB62D, 0xB647, GB_LVT},
+	{0xB648, 0xB648, GB_LV},
+	{0xB649, 0xB663, GB_LVT},
+	{0xB664, 0xB664, GB_LV},
+	{0xB665, 0xB67F, GB_LVT},
+	{0xB680, 0xB680, GB_LV},
+	{0xB681, 0xB69B, GB_LVT},
+	{0xB69C, 0xB69C, GB_LV},
+	{0xB69D, 0xB6B7, GB_LVT},
+	{0xB6B8, 0xB6B8, GB_LV},
+	{0xB6B9, 0xB6D3, GB_LVT},
+	{0xB6D4, 0xB6D4, GB_LV},
+	{0xB6D5, 0xB6EF, GB_LVT},
+	{0xB6F0, 0xB6F0, GB_LV},
+	{0xB6F1, 0xB70B, GB_LVT},
+	{0xB70C, 0xB70C, GB_LV},
+	{0xB70D, 0xB727, GB_LVT},
+	{0xB728, 0xB728, GB_LV},
+	{0xB729, 0xB743, GB_LVT},
+	{0xB744, 0xB744, GB_LV},
+	{0xB745, 0xB75F, GB_LVT},
+	{0xB760, 0xB760, GB_LV},
+	{0xB761, 0xB77B, GB_LVT},
+	{0xB77C, 0xB77C, GB_LV},
+	{0xB77D, 0xB797, GB_LVT},
+	{0xB798, 0xB798, GB_LV},
+	{0xB799, 0xB7B3, GB_LVT},
+	{0xB7B4, 0xB7B4, GB_LV},
+	{0xB7B5, 0xB7CF, GB_LVT},
+	{0xB7D0, 0xB7D0, GB_LV},
+	{0xB7D1, 0xB7EB, GB_LVT},
+	{0xB7EC, 0xB7EC, GB_LV},
+	{0xB7ED, 0xB807, GB_LVT},
+	{0xB808, 0xB808, GB_LV},
+	{0xB809, 0xB823, GB_LVT},
+	{0xB824, 0xB824, GB_LV},
+	{0xB825, 0xB83F, GB_LVT},
+	{0xB840, 0xB840, GB_LV},
+	{0xB841, 0xB85B, GB_LVT},
+	{0xB85C, 0xB85C, GB_LV},
+	{0xB85D, 0xB877, GB_LVT},
+	{0xB878, 0xB878, GB_LV},
+	{0xB879, 0xB893, GB_LVT},
+	{0xB894, 0xB894, GB_LV},
+	{0xB895, 0xB8AF, GB_LVT},
+	{0xB8B0, 0xB8B0, GB_LV},
+	{0xB8B1, 0xB8CB, GB_LVT},
+	{0xB8CC, 0xB8CC, GB_LV},
+	{0xB8CD, 0xB8E7, GB_LVT},
+	{0xB8E8, 0xB8E8, GB_LV},
+	{0xB8E9, 0xB903, GB_LVT},
+	{0xB904, 0xB904, GB_LV},
+	{0xB905, 0xB91F, GB_LVT},
+	{0xB920, 0xB920, GB_LV},
+	{0xB921, 0xB93B, GB_LVT},
+	{0xB93C, 0xB93C, GB_LV},
+	{0xB93D, 0xB957, GB_LVT},
+	{0xB958, 0xB958, GB_LV},
+	{0xB959, 0xB973, GB_LVT},
+	{0xB974, 0xB974, GB_LV},
+	{0xB975, 0xB98F, GB_LVT},
+	{0xB990, 0xB990, GB_LV},
+	{0xB991, 0xB9AB, GB_LVT},
+	{0xB9AC, 0xB9AC, GB_LV},
+	{0xB9AD, 0xB9C7, GB_LVT},
+	{0xB9C8, 0xB9C8, GB_LV},
+	{0xB9C9, 0xB9E3, GB_LVT},
+	{0xB9E4, 0xB9E4, GB_LV},
+	{0xB9E5, 0xB9FF, GB_LVT},
+	{0xBA00, 0xBA00, GB_LV},
+	{0xBA01, 0xBA1B, GB_LVT},
+	{0xBA1C, 0xBA1C, GB_LV},
+	{0xBA1D, 0xBA37, GB_LVT},
+	{0xBA38, 0xBA38, GB_LV},
+	{0xBA39, 0xBA53, GB_LVT},
+	{0xBA54, 0xBA54, GB_LV},
+	{0xBA55, 0xBA6F, GB_LVT},
+	{0xBA70, 0xBA70, GB_LV},
+	{0xBA71, 0xBA8B, GB_LVT},
+	{0xBA8C, 0xBA8C, GB_LV},
+	{0xBA8D, 0xBAA7, GB_LVT},
+	{0xBAA8, 0xBAA8, GB_LV},
+	{0xBAA9, 0xBAC3, GB_LVT},
+	{0xBAC4, 0xBAC4, GB_LV},
+	{0xBAC5, 0xBADF, GB_LVT},
+	{0xBAE0, 0xBAE0, GB_LV},
+	{0xBAE1, 0xBAFB, GB_LVT},
+	{0xBAFC, 0xBAFC, GB_LV},
+	{0xBAFD, 0xBB17, GB_LVT},
+	{0xBB18, 0xBB18, GB_LV},
+	{0xBB19, 0xBB33, GB_LVT},
+	{0xBB34, 0xBB34, GB_LV},
+	{0xBB35, 0xBB4F, GB_LVT},
+	{0xBB50, 0xBB50, GB_LV},
+	{0xBB51, 0xBB6B, GB_LVT},
+	{0xBB6C, 0xBB6C, GB_LV},
+	{0xBB6D, 0xBB87, GB_LVT},
+	{0xBB88, 0xBB88, GB_LV},
+	{0xBB89, 0xBBA3, GB_LVT},
+	{0xBBA4, 0xBBA4, GB_LV},
+	{0xBBA5, 0xBBBF, GB_LVT},
+	{0xBBC0, 0xBBC0, GB_LV},
+	{0xBBC1, 0xBBDB, GB_LVT},
+	{0xBBDC, 0xBBDC, GB_LV},
+	{0xBBDD, 0xBBF7, GB_LVT},
+	{0xBBF8, 0xBBF8, GB_LV},
+	{0xBBF9, 0xBC13, GB_LVT},
+	{0xBC14, 0xBC14, GB_LV},
+	{0xBC15, 0xBC2F, GB_LVT},
+	{0xBC30, 0xBC30, GB_LV},
+	{0xBC31, 0xBC4B, GB_LVT},
+	{0xBC4C, 0xBC4C, GB_LV},
+	{0xBC4D, 0xBC67, GB_LVT},
+	{0xBC68, 0xBC68, GB_LV},
+	{0xBC69, 0xBC83, GB_LVT},
+	{0xBC84, 0xBC84, GB_LV},
+	{0xBC85, 0xBC9F, GB_LVT},
+	{0xBCA0, 0xBCA0, GB_LV},
+	{0xBCA1, 0xBCBB, GB_LVT},
+	{0xBCBC, 0xBCBC, GB_LV},
+	{0xBCBD, 0xBCD7, GB_LVT},
+	{0xBCD8, 0xBCD8, GB_LV},
+	{0xBCD9, 0xBCF3, GB_LVT},
+	{0xBCF4, 0xBCF4, GB_LV},
+	{0xBCF5, 0xBD0F, GB_LVT},
+	{0xBD10, 0xBD10, GB_LV},
+	{0xBD11, 0xBD2B, GB_LVT},
+	{0xBD2C, 0xBD2C, GB_LV},
+	{0xBD2D, 0xBD47, GB_LVT},
+	{0xBD48, 0xBD48, GB_LV},
+	{0xBD49, 0xBD63, GB_LVT},
+	{0xBD64, 0xBD64, GB_LV},
+	{0xBD65, 0xBD7F, GB_LVT},
+	{0xBD80, 0xBD80, GB_LV},
+	{0xBD81, 0xBD9B, GB_LVT},
+	{0xBD9C, 0xBD9C, GB_LV},
+	{0xBD9D, 0xBDB7, GB_LVT},
+	{0xBDB8, 0xBDB8, GB_LV},
+	{0xBDB9, 0xBDD3, GB_LVT},
+	{0xBDD4, 0xBDD4, GB_LV},
+	{0xBDD5, 0xBDEF, GB_LVT},
+	{0xBDF0, 0xBDF0, GB_LV},
+	{0xBDF1, 0xBE0B, GB_LVT},
+	{0xBE0C, 0xBE0C, GB_LV},
+	{0xBE0D, 0xBE27, GB_LVT},
+	{0xBE28, 0xBE28, GB_LV},
+	{0xBE29, 0xBE43, GB_LVT},
+	{0xBE44, 0xBE44, GB_LV},
+	{0xBE45, 0xBE5F, GB_LVT},
+	{0xBE60, 0xBE60, GB_LV},
+	{0xBE61, 0xBE7B, GB_LVT},
+	{0xBE7C, 0xBE7C, GB_LV},
+	{0xBE7D, 0xBE97, GB_LVT},
+	{0xBE98, 0xBE98, GB_LV},
+	{0xBE99, 0xBEB3, GB_LVT},
+	{0xBEB4, 0xBEB4, GB_LV},
+	{0xBEB5, 0xBECF, GB_LVT},
+	{0xBED0, 0xBED0, GB_LV},
+	{0xBED1, 0xBEEB, GB_LVT},
+	{0xBEEC, 0xBEEC, GB_LV},
+	{0xBEED, 0xBF07, GB_LVT},
+	{0xBF08, 0xBF08, GB_LV},
+	{0xBF09, 0xBF23, GB_LVT},
+	{0xBF24, 0xBF24, GB_LV},
+	{0xBF25, 0xBF3F, GB_LVT},
+	{0xBF40, 0xBF40, GB_LV},
+	{0xBF41, 0xBF5B, GB_LVT},
+	{0xBF5C, 0xBF5C, GB_LV},
+	{0xBF5D, 0xBF77, GB_LVT},
+	{0xBF78, 0xBF78, GB_LV},
+	{0xBF79, 0xBF93, GB_LVT},
+	{0xBF94, 0xBF94, GB_LV},
+	{0xBF95, 0xBFAF, GB_LVT},
+	{0xBFB0, 0xBFB0, GB_LV},
+	{0xBFB1, 0xBFCB, GB_LVT},
+	{0xBFCC, 0xBFCC, GB_LV},
+	{0xBFCD, 0xBFE7, GB_LVT},
+	{0xBFE8, 0xBFE8, GB_LV},
+	{0xBFE9, 0xC003, GB_LVT},
+	{0xC004, 0xC004, GB_LV},
+	{0xC005, 0xC01F, GB_LVT},
+	{0xC020, 0xC020, GB_LV},
+	{0xC021, 0xC03B, GB_LVT},
+	{0xC03C, 0xC03C, GB_LV},
+	{0xC03D, 0xC057, GB_LVT},
+	{0xC058, 0xC058, GB_LV},
+	{0xC059, 0xC073, GB_LVT},
+	{0xC074, 0xC074, GB_LV},
+	{0xC075, 0xC08F, GB_LVT},
+	{0xC090, 0xC090, GB_LV},
+	{0xC091, 0xC0AB, GB_LVT},
+	{0xC0AC, 0xC0AC, GB_LV},
+	{0xC0AD, 0xC0C7, GB_LVT},
+	{0xC0C8, 0xC0C8, GB_LV},
+	{0xC0C9, 0xC0E3, GB_LVT},
+	{0xC0E4, 0xC0E4, GB_LV},
+	{0xC0E5, 0xC0FF, GB_LVT},
+	{0xC100, 0xC100, GB_LV},
+	{0xC101, 0xC11B, GB_LVT},
+	{0xC11C, 0xC11C, GB_LV},
+	{0xC11D, 0xC137, GB_LVT},
+	{0xC138, 0xC138, GB_LV},
+	{0xC139, 0xC153, GB_LVT},
+	{0xC154, 0xC154, GB_LV},
+	{0xC155, 0xC16F, GB_LVT},
+	{0xC170, 0xC170, GB_LV},
+	{0xC171, 0xC18B, GB_LVT},
+	{0xC18C, 0xC18C, GB_LV},
+	{0xC18D, 0xC1A7, GB_LVT},
+	{0xC1A8, 0xC1A8, GB_LV},
+	{0xC1A9, 0xC1C3, GB_LVT},
+	{0xC1C4, 0xC1C4, GB_LV},
+	{0xC1C5, 0xC1DF, GB_LVT},
+	{0xC1E0, 0xC1E0, GB_LV},
+	{0xC1E1, 0xC1FB, GB_LVT},
+	{0xC1FC, 0xC1FC, GB_LV},
+	{0xC1FD, 0xC217, GB_LVT},
+	{0xC218, 0xC218, GB_LV},
+	{0xC219, 0xC233, GB_LVT},
+	{0xC234, 0xC234, GB_LV},
+	{0xC235, 0xC24F, GB_LVT},
+	{0xC250, 0xC250, GB_LV},
+	{0xC251, 0xC26B, GB_LVT},
+	{0xC26C, 0xC26C, GB_LV},
+	{0xC26D, 0xC287, GB_LVT},
+	{0xC288, 0xC288, GB_LV},
+	{0xC289, 0xC2A3, GB_LVT},
+	{0xC2A4, 0xC2A4, GB_LV},
+	{0xC2A5, 0xC2BF, GB_LVT},
+	{0xC2C0, 0xC2C0, GB_LV},
+	{0xC2C1, 0xC2DB, GB_LVT},
+	{0xC2DC, 0xC2DC, GB_LV},
+	{0xC2DD, 0xC2F7, GB_LVT},
+	{0xC2F8, 0xC2F8, GB_LV},
+	{0xC2F9, 0xC313, GB_LVT},
+	{0xC314, 0xC314, GB_LV},
+	{0xC315, 0xC32F, GB_LVT},
+	{0xC330, 0xC330, GB_LV},
+	{0xC331, 0xC34B, GB_LVT},
+	{0xC34C, 0xC34C, GB_LV},
+	{0xC34D, 0xC367, GB_LVT},
+	{0xC368, 0xC368, GB_LV},
+	{0xC369, 0xC383, GB_LVT},
+	{0xC384, 0xC384, GB_LV},
+	{0xC385, 0xC39F, GB_LVT},
+	{0xC3A0, 0xC3A0, GB_LV},
+	{0xC3A1, 0xC3BB, GB_LVT},
+	{0xC3BC, 0xC3BC, GB_LV},
+	{0xC3BD, 0xC3D7, GB_LVT},
+	{0xC3D8, 0xC3D8, GB_LV},
+	{0xC3D9, 0xC3F3, GB_LVT},
+	{0xC3F4, 0xC3F4, GB_LV},
+	{0xC3F5, 0xC40F, GB_LVT},
+	{0xC410, 0xC410, GB_LV},
+	{0xC411, 0xC42B, GB_LVT},
+	{0xC42C, 0xC42C, GB_LV},
+	{0xC42D, 0xC447, GB_LVT},
+	{0xC448, 0xC448, GB_LV},
+	{0xC449, 0xC463, GB_LVT},
+	{0xC464, 0xC464, GB_LV},
+	{0xC465, 0xC47F, GB_LVT},
+	{0xC480, 0xC480, GB_LV},
+	{0xC481, 0xC49B, GB_LVT},
+	{0xC49C, 0xC49C, GB_LV},
+	{0xC49D, 0xC4B7, GB_LVT},
+	{0xC4B8, 0xC4B8, GB_LV},
+	{0xC4B9, 0xC4D3, GB_LVT},
+	{0xC4D4, 0xC4D4, GB_LV},
+	{0xC4D5, 0xC4EF, GB_LVT},
+	{0xC4F0, 0xC4F0, GB_LV},
+	{0xC4F1, 0xC50B, GB_LVT},
+	{0xC50C, 0xC50C, GB_LV},
+	{0xC50D, 0xC527, GB_LVT},
+	{0xC528, 0xC528, GB_LV},
+	{0xC529, 0xC543, GB_LVT},
+	{0xC544, 0xC544, GB_LV},
+	{0xC545, 0xC55F, GB_LVT},
+	{0xC560, 0xC560, GB_LV},
+	{0xC561, 0xC57B, GB_LVT},
+	{0xC57C, 0xC57C, GB_LV},
+	{0xC57D, 0xC597, GB_LVT},
+	{0xC598, 0xC598, GB_LV},
+	{0xC599, 0xC5B3, GB_LVT},
+	{0xC5B4, 0xC5B4, GB_LV},
+	{0xC5B5, 0xC5CF, GB_LVT},
+	{0xC5D0, 0xC5D0, GB_LV},
+	{0xC5D1, 0xC5EB, GB_LVT},
+	{0xC5EC, 0xC5EC, GB_LV},
+	{0xC5ED, 0xC607, GB_LVT},
+	{0xC608, 0xC608, GB_LV},
+	{0xC609, 0xC623, GB_LVT},
+	{0xC624, 0xC624, GB_LV},
+	{0xC625, 0xC63F, GB_LVT},
+	{0xC640, 0xC640, GB_LV},
+	{0xC641, 0xC65B, GB_LVT},
+	{0xC65C, 0xC65C, GB_LV},
+	{0xC65D, 0xC677, GB_LVT},
+	{0xC678, 0xC678, GB_LV},
+	{0xC679, 0xC693, GB_LVT},
+	{0xC694, 0xC694, GB_LV},
+	{0xC695, 0xC6AF, GB_LVT},
+	{0xC6B0, 0xC6B0, GB_LV},
+	{0xC6B1, 0xC6CB, GB_LVT},
+	{0xC6CC, 0xC6CC, GB_LV},
+	{0xC6CD, 0xC6E7, GB_LVT},
+	{0xC6E8, 0xC6E8, GB_LV},
+	{0xC6E9, 0xC703, GB_LVT},
+	{0xC704, 0xC704, GB_LV},
+	{0xC705, 0xC71F, GB_LVT},
+	{0xC720, 0xC720, GB_LV},
+	{0xC721, 0xC73B, GB_LVT},
+	{0xC73C, 0xC73C, GB_LV},
+	{0xC73D, 0xC757, GB_LVT},
+	{0xC758, 0xC758, GB_LV},
+	{0xC759, 0xC773, GB_LVT},
+	{0xC774, 0xC774, GB_LV},
+	{0xC775, 0xC78F, GB_LVT},
+	{0xC790, 0xC790, GB_LV},
+	{0xC791, 0xC7AB, GB_LVT},
+	{0xC7AC, 0xC7AC, GB_LV},
+	{0xC7AD, 0xC7C7, GB_LVT},
+	{0xC7C8, 0xC7C8, GB_LV},
+	{0xC7C9, 0xC7E3, GB_LVT},
+	{0xC7E4, 0xC7E4, GB_LV},
+	{0xC7E5, 0xC7FF, GB_LVT},
+	{0xC800, 0xC800, GB_LV},
+	{0xC801, 0xC81B, GB_LVT},
+	{0xC81C, 0xC81C, GB_LV},
+	{0xC81D, 0xC837, GB_LVT},
+	{0xC838, 0xC838, GB_LV},
+	{0xC839, 0xC853, GB_LVT},
+	{0xC854, 0xC854, GB_LV},
+	{0xC855, 0xC86F, GB_LVT},
+	{0xC870, 0xC870, GB_LV},
+	{0xC871, 0xC88B, GB_LVT},
+	{0xC88C, 0xC88C, GB_LV},
+	{0xC88D, 0xC8A7, GB_LVT},
+	{0xC8A8, 0xC8A8, GB_LV},
+	{0xC8A9, 0xC8C3, GB_LVT},
+	{0xC8C4, 0xC8C4, GB_LV},
+	{0xC8C5, 0xC8DF, GB_LVT},
+	{0xC8E0, 0xC8E0, GB_LV},
+	{0xC8E1, 0xC8FB, GB_LVT},
+	{0xC8FC, 0xC8FC, GB_LV},
+	{0xC8FD, 0xC917, GB_LVT},
+	{0xC918, 0xC918, GB_LV},
+	{0xC919, 0xC933, GB_LVT},
+	{0xC934, 0xC934, GB_LV},
+	{0xC935, 0xC94F, GB_LVT},
+	{0xC950, 0xC950, GB_LV},
+	{0xC951, 0xC96B, GB_LVT},
+	{0xC96C, 0xC96C, GB_LV},
+	{0xC96D, 0xC987, GB_LVT},
+	{0xC988, 0xC988, GB_LV},
+	{0xC989, 0xC9A3, GB_LVT},
+	{0xC9A4, 0xC9A4, GB_LV},
+	{0xC9A5, 0xC9BF, GB_LVT},
+	{0xC9C0, 0xC9C0, GB_LV},
+	{0xC9C1, 0xC9DB, GB_LVT},
+	{0xC9DC, 0xC9DC, GB_LV},
+	{0xC9DD, 0xC9F7, GB_LVT},
+	{0xC9F8, 0xC9F8, GB_LV},
+	{0xC9F9, 0xCA13, GB_LVT},
+	{0xCA14, 0xCA14, GB_LV},
+	{0xCA15, 0xCA2F, GB_LVT},
+	{0xCA30, 0xCA30, GB_LV},
+	{0xCA31, 0xCA4B, GB_LVT},
+	{0xCA4C, 0xCA4C, GB_LV},
+	{0xCA4D, 0xCA67, GB_LVT},
+	{0xCA68, 0xCA68, GB_LV},
+	{0xCA69, 0xCA83, GB_LVT},
+	{0xCA84, 0xCA84, GB_LV},
+	{0xCA85, 0xCA9F, GB_LVT},
+	{0xCAA0, 0xCAA0, GB_LV},
+	{0xCAA1, 0xCABB, GB_LVT},
+	{0xCABC, 0xCABC, GB_LV},
+	{0xCABD, 0xCAD7, GB_LVT},
+	{0xCAD8, 0xCAD8, GB_LV},
+	{0xCAD9, 0xCAF3, GB_LVT},
+	{0xCAF4, 0xCAF4, GB_LV},
+	{0xCAF5, 0xCB0F, GB_LVT},
+	{0xCB10, 0xCB10, GB_LV},
+	{0xCB11, 0xCB2B, GB_LVT},
+	{0xCB2C, 0xCB2C, GB_LV},
+	{0xCB2D, 0xCB47, GB_LVT},
+	{0xCB48, 0xCB48, GB_LV},
+	{0xCB49, 0xCB63, GB_LVT},
+	{0xCB64, 0xCB64, GB_LV},
+	{0xCB65, 0xCB7F, GB_LVT},
+	{0xCB80, 0xCB80, GB_LV},
+	{0xCB81, 0xCB9B, GB_LVT},
+	{0xCB9C, 0xCB9C, GB_LV},
+	{0xCB9D, 0xCBB7, GB_LVT},
+	{0xCBB8, 0xCBB8, GB_LV},
+	{0xCBB9, 0xCBD3, GB_LVT},
+	{0xCBD4, 0xCBD4, GB_LV},
+	{0xCBD5, 0xCBEF, GB_LVT},
+	{0xCBF0, 0xCBF0, GB_LV},
+	{0xCBF1, 0xCC0B, GB_LVT},
+	{0xCC0C, 0xCC0C, GB_LV},
+	{0xCC0D, 0xCC27, GB_LVT},
+	{0xCC28, 0xCC28, GB_LV},
+	{0xCC29, 0xCC43, GB_LVT},
+	{0xCC44, 0xCC44, GB_LV},
+	{0xCC45, 0xCC5F, GB_LVT},
+	{0xCC60, 0xCC60, GB_LV},
+	{0xCC61, 0xCC7B, GB_LVT},
+	{0xCC7C, 0xCC7C, GB_LV},
+	{0xCC7D, 0xCC97, GB_LVT},
+	{0xCC98, 0xCC98, GB_LV},
+	{0xCC99, 0xCCB3, GB_LVT},
+	{0xCCB4, 0xCCB4, GB_LV},
+	{0xCCB5, 0xCCCF, GB_LVT},
+	{0xCCD0, 0xCCD0, GB_LV},
+	{0xCCD1, 0xCCEB, GB_LVT},
+	{0xCCEC, 0xCCEC, GB_LV},
+	{0xCCED, 0xCD07, GB_LVT},
+	{0xCD08, 0xCD08, GB_LV},
+	{0xCD09, 0xCD23, GB_LVT},
+	{0xCD24, 0xCD24, GB_LV},
+	{0xCD25, 0xCD3F, GB_LVT},
+	{0xCD40, 0xCD40, GB_LV},
+	{0xCD41, 0xCD5B, GB_LVT},
+	{0xCD5C, 0xCD5C, GB_LV},
+	{0xCD5D, 0xCD77, GB_LVT},
+	{0xCD78, 0xCD78, GB_LV},
+	{0xCD79, 0xCD93, GB_LVT},
+	{0xCD94, 0xCD94, GB_LV},
+	{0xCD95, 0xCDAF, GB_LVT},
+	{0xCDB0, 0xCDB0, GB_LV},
+	{0xCDB1, 0xCDCB, GB_LVT},
+	{0xCDCC, 0xCDCC, GB_LV},
+	{0xCDCD, 0xCDE7, GB_LVT},
+	{0xCDE8, 0xCDE8, GB_LV},
+	{0xCDE9, 0xCE03, GB_LVT},
+	{0xCE04, 0xCE04, GB_LV},
+	{0xCE05, 0xCE1F, GB_LVT},
+	{0xCE20, 0xCE20, GB_LV},
+	{0xCE21, 0xCE3B, GB_LVT},
+	{0xCE3C, 0xCE3C, GB_LV},
+	{0xCE3D, 0xCE57, GB_LVT},
+	{0xCE58, 0xCE58, GB_LV},
+	{0xCE59, 0xCE73, GB_LVT},
+	{0xCE74, 0xCE74, GB_LV},
+	{0xCE75, 0xCE8F, GB_LVT},
+	{0xCE90, 0xCE90, GB_LV},
+	{0xCE91, 0xCEAB, GB_LVT},
+	{0xCEAC, 0xCEAC, GB_LV},
+	{0xCEAD, 0xCEC7, GB_LVT},
+	{0xCEC8, 0xCEC8, GB_LV},
+	{0xCEC9, 0xCEE3, GB_LVT},
+	{0xCEE4, 0xCEE4, GB_LV},
+	{0xCEE5, 0xCEFF, GB_LVT},
+	{0xCF00, 0xCF00, GB_LV},
+	{0xCF01, 0xCF1B, GB_LVT},
+	{0xCF1C, 0xCF1C, GB_LV},
+	{0xCF1D, 0xCF37, GB_LVT},
+	{0xCF38, 0xCF38, GB_LV},
+	{0xCF39, 0xCF53, GB_LVT},
+	{0xCF54, 0xCF54, GB_LV},
+	{0xCF55, 0xCF6F, GB_LVT},
+	{0xCF70, 0xCF70, GB_LV},
+	{0xCF71, 0xCF8B, GB_LVT},
+	{0xCF8C, 0xCF8C, GB_LV},
+	{0xCF8D, 0xCFA7, GB_LVT},
+	{0xCFA8, 0xCFA8, GB_LV},
+	{0xCFA9, 0xCFC3, GB_LVT},
+	{0xCFC4, 0xCFC4, GB_LV},
+	{0xCFC5, 0xCFDF, GB_LVT},
+	{0xCFE0, 0xCFE0, GB_LV},
+	{0xCFE1, 0xCFFB, GB_LVT},
+	{0xCFFC, 0xCFFC, GB_LV},
+	{0xCFFD, 0xD017, GB_LVT},
+	{0xD018, 0xD018, GB_LV},
+	{0xD019, 0xD033, GB_LVT},
+	{0xD034, 0xD034, GB_LV},
+	{0xD035, 0xD04F, GB_LVT},
+	{0xD050, 0xD050, GB_LV},
+	{0xD051, 0xD06B, GB_LVT},
+	{0xD06C, 0xD06C, GB_LV},
+	{0xD06D, 0xD087, GB_LVT},
+	{0xD088, 0xD088, GB_LV},
+	{0xD089, 0xD0A3, GB_LVT},
+	{0xD0A4, 0xD0A4, GB_LV},
+	{0xD0A5, 0xD0BF, GB_LVT},
+	{0xD0C0, 0xD0C0, GB_LV},
+	{0xD0C1, 0xD0DB, GB_LVT},
+	{0xD0DC, 0xD0DC, GB_LV},
+	{0xD0DD, 0xD0F7, GB_LVT},
+	{0xD0F8, 0xD0F8, GB_LV},
+	{0xD0F9, 0xD113, GB_LVT},
+	{0xD114, 0xD114, GB_LV},
+	{0xD115, 0xD12F, GB_LVT},
+	{0xD130, 0xD130, GB_LV},
+	{0xD131, 0xD14B, GB_LVT},
+	{0xD14C, 0xD14C, GB_LV},
+	{0xD14D, 0xD167, GB_LVT},
+	{0xD168, 0xD168, GB_LV},
+	{0xD169, 0xD183, GB_LVT},
+	{0xD184, 0xD184, GB_LV},
+	{0xD185, 0xD19F, GB_LVT},
+	{0xD1A0, 0xD1A0, GB_LV},
+	{0xD1A1, 0xD1BB, GB_LVT},
+	{0xD1BC, 0xD1BC, GB_LV},
+	{0xD1BD, 0xD1D7, GB_LVT},
+	{0xD1D8, 0xD1D8, GB_LV},
+	{0xD1D9, 0xD1F3, GB_LVT},
+	{0xD1F4, 0xD1F4, GB_LV},
+	{0xD1F5, 0xD20F, GB_LVT},
+	{0xD210, 0xD210, GB_LV},
+	{0xD211, 0xD22B, GB_LVT},
+	{0xD22C, 0xD22C, GB_LV},
+	{0xD22D, 0xD247, GB_LVT},
+	{0xD248, 0xD248, GB_LV},
+	{0xD249, 0xD263, GB_LVT},
+	{0xD264, 0xD264, GB_LV},
+	{0xD265, 0xD27F, GB_LVT},
+	{0xD280, 0xD280, GB_LV},
+	{0xD281, 0xD29B, GB_LVT},
+	{0xD29C, 0xD29C, GB_LV},
+	{0xD29D, 0xD2B7, GB_LVT},
+	{0xD2B8, 0xD2B8, GB_LV},
+	{0xD2B9, 0xD2D3, GB_LVT},
+	{0xD2D4, 0xD2D4, GB_LV},
+	{0xD2D5, 0xD2EF, GB_LVT},
+	{0xD2F0, 0xD2F0, GB_LV},
+	{0xD2F1, 0xD30B, GB_LVT},
+	{0xD30C, 0xD30C, GB_LV},
+	{0xD30D, 0xD327, GB_LVT},
+	{0xD328, 0xD328, GB_LV},
+	{0xD329, 0xD343, GB_LVT},
+	{0xD344, 0xD344, GB_LV},
+	{0xD345, 0xD35F, GB_LVT},
+	{0xD360, 0xD360, GB_LV},
+	{0xD361, 0xD37B, GB_LVT},
+	{0xD37C, 0xD37C, GB_LV},
+	{0xD37D, 0xD397, GB_LVT},
+	{0xD398, 0xD398, GB_LV},
+	{0xD399, 0xD3B3, GB_LVT},
+	{0xD3B4, 0xD3B4, GB_LV},
+	{0xD3B5, 0xD3CF, GB_LVT},
+	{0xD3D0, 0xD3D0, GB_LV},
+	{0xD3D1, 0xD3EB, GB_LVT},
+	{0xD3EC, 0xD3EC, GB_LV},
+	{0xD3ED, 0xD407, GB_LVT},
+	{0xD408, 0xD408, GB_LV},
+	{0xD409, 0xD423, GB_LVT},
+	{0xD424, 0xD424, GB_LV},
+	{0xD425, 0xD43F, GB_LVT},
+	{0xD440, 0xD440, GB_LV},
+	{0xD441, 0xD45B, GB_LVT},
+	{0xD45C, 0xD45C, GB_LV},
+	{0xD45D, 0xD477, GB_LVT},
+	{0xD478, 0xD478, GB_LV},
+	{0xD479, 0xD493, GB_LVT},
+	{0xD494, 0xD494, GB_LV},
+	{0xD495, 0xD4AF, GB_LVT},
+	{0xD4B0, 0xD4B0, GB_LV},
+	{0xD4B1, 0xD4CB, GB_LVT},
+	{0xD4CC, 0xD4CC, GB_LV},
+	{0xD4CD, 0xD4E7, GB_LVT},
+	{0xD4E8, 0xD4E8, GB_LV},
+	{0xD4E9, 0xD503, GB_LVT},
+	{0xD504, 0xD504, GB_LV},
+	{0xD505, 0xD51F, GB_LVT},
+	{0xD520, 0xD520, GB_LV},
+	{0xD521, 0xD53B, GB_LVT},
+	{0xD53C, 0xD53C, GB_LV},
+	{0xD53D, 0xD557, GB_LVT},
+	{0xD558, 0xD558, GB_LV},
+	{0xD559, 0xD573, GB_LVT},
+	{0xD574, 0xD574, GB_LV},
+	{0xD575, 0xD58F, GB_LVT},
+	{0xD590, 0xD590, GB_LV},
+	{0xD591, 0xD5AB, GB_LVT},
+	{0xD5AC, 0xD5AC, GB_LV},
+	{0xD5AD, 0xD5C7, GB_LVT},
+	{0xD5C8, 0xD5C8, GB_LV},
+	{0xD5C9, 0xD5E3, GB_LVT},
+	{0xD5E4, 0xD5E4, GB_LV},
+	{0xD5E5, 0xD5FF, GB_LVT},
+	{0xD600, 0xD600, GB_LV},
+	{0xD601, 0xD61B, GB_LVT},
+	{0xD61C, 0xD61C, GB_LV},
+	{0xD61D, 0xD637, GB_LVT},
+	{0xD638, 0xD638, GB_LV},
+	{0xD639, 0xD653, GB_LVT},
+	{0xD654, 0xD654, GB_LV},
+	{0xD655, 0xD66F, GB_LVT},
+	{0xD670, 0xD670, GB_LV},
+	{0xD671, 0xD68B, GB_LVT},
+	{0xD68C, 0xD68C, GB_LV},
+	{0xD68D, 0xD6A7, GB_LVT},
+	{0xD6A8, 0xD6A8, GB_LV},
+	{0xD6A9, 0xD6C3, GB_LVT},
+	{0xD6C4, 0xD6C4, GB_LV},
+	{0xD6C5, 0xD6DF, GB_LVT},
+	{0xD6E0, 0xD6E0, GB_LV},
+	{0xD6E1, 0xD6FB, GB_LVT},
+	{0xD6FC, 0xD6FC, GB_LV},
+	{0xD6FD, 0xD717, GB_LVT},
+	{0xD718, 0xD718, GB_LV},
+	{0xD719, 0xD733, GB_LVT},
+	{0xD734, 0xD734, GB_LV},
+	{0xD735, 0xD74F, GB_LVT},
+	{0xD750, 0xD750, GB_LV},
+	{0xD751, 0xD76B, GB_LVT},
+	{0xD76C, 0xD76C, GB_LV},
+	{0xD76D, 0xD787, GB_LVT},
+	{0xD788, 0xD788, GB_LV},
+	{0xD789, 0xD7A3, GB_LVT},
+	{0xD7B0, 0xD7C6, GB_V},
+	{0xD7CB, 0xD7FB, GB_T},
+	{0xFB1E, 0xFB1E, GB_EXTEND},
+	{0xFE00, 0xFE0F, GB_EXTEND},
+	{0xFE20, 0xFE2F, GB_EXTEND},
+	{0xFEFF, 0xFEFF, GB_CONTROL},
+	{0xFF9E, 0xFF9F, GB_EXTEND},
+	{0xFFF0, 0xFFFB, GB_CONTROL},
+	{0x101FD, 0x101FD, GB_EXTEND},
+	{0x102E0, 0x102E0, GB_EXTEND},
+	{0x10376, 0x1037A, GB_EXTEND},
+	{0x10A01, 0x10A03, GB_EXTEND},
+	{0x10A05, 0x10A06, GB_EXTEND},
+	{0x10A0C, 0x10A0F, GB_EXTEND},
+	{0x10A38, 0x10A3A, GB_EXTEND},
+	{0x10A3F, 0x10A3F, GB_EXTEND},
+	{0x10AE5, 0x10AE6, GB_EXTEND},
+	{0x10D24, 0x10D27, GB_EXTEND},
+	{0x10EAB, 0x10EAC, GB_EXTEND},
+	{0x10F46, 0x10F50, GB_EXTEND},
+	{0x10F82, 0x10F85, GB_EXTEND},
+	{0x11000, 0x11000, GB_SPACING_MARK},
+	{0x11001, 0x11001, GB_EXTEND},
+	{0x11002, 0x11002, GB_SPACING_MARK},
+	{0x11038, 0x11046, GB_EXTEND},
+	{0x11070, 0x11070, GB_EXTEND},
+	{0x11073, 0x11074, GB_EXTEND},
+	{0x1107F, 0x11081, GB_EXTEND},
+	{0x11082, 0x11082, GB_SPACING_MARK},
+	{0x110B0, 0x110B2, GB_SPACING_MARK},
+	{0x110B3, 0x110B6, GB_EXTEND},
+	{0x110B7, 0x110B8, GB_SPACING_MARK},
+	{0x110B9, 0x110BA, GB_EXTEND},
+	{0x110BD, 0x110BD, GB_PREPEND},
+	{0x110C2, 0x110C2, GB_EXTEND},
+	{0x110CD, 0x110CD, GB_PREPEND},
+	{0x11100, 0x11102, GB_EXTEND},
+	{0x11127, 0x1112B, GB_EXTEND},
+	{0x1112C, 0x1112C, GB_SPACING_MARK},
+	{0x1112D, 0x11134, GB_EXTEND},
+	{0x11145, 0x11146, GB_SPACING_MARK},
+	{0x11173, 0x11173, GB_EXTEND},
+	{0x11180, 0x11181, GB_EXTEND},
+	{0x11182, 0x11182, GB_SPACING_MARK},
+	{0x111B3, 0x111B5, GB_SPACING_MARK},
+	{0x111B6, 0x111BE, GB_EXTEND},
+	{0x111BF, 0x111C0, GB_SPACING_MARK},
+	{0x111C2, 0x111C3, GB_PREPEND},
+	{0x111C9, 0x111CC, GB_EXTEND},
+	{0x111CE, 0x111CE, GB_SPACING_MARK},
+	{0x111CF, 0x111CF, GB_EXTEND},
+	{0x1122C, 0x1122E, GB_SPACING_MARK},
+	{0x1122F, 0x11231, GB_EXTEND},
+	{0x11232, 0x11233, GB_SPACING_MARK},
+	{0x11234, 0x11234, GB_EXTEND},
+	{0x11235, 0x11235, GB_SPACING_MARK},
+	{0x11236, 0x11237, GB_EXTEND},
+	{0x1123E, 0x1123E, GB_EXTEND},
+	{0x112DF, 0x112DF, GB_EXTEND},
+	{0x112E0, 0x112E2, GB_SPACING_MARK},
+	{0x112E3, 0x112EA, GB_EXTEND},
+	{0x11300, 0x11301, GB_EXTEND},
+	{0x11302, 0x11303, GB_SPACING_MARK},
+	{0x1133B, 0x1133C, GB_EXTEND},
+	{0x1133E, 0x1133E, GB_EXTEND},
+	{0x1133F, 0x1133F, GB_SPACING_MARK},
+	{0x11340, 0x11340, GB_EXTEND},
+	{0x11341, 0x11344, GB_SPACING_MARK},
+	{0x11347, 0x11348, GB_SPACING_MARK},
+	{0x1134B, 0x1134D, GB_SPACING_MARK},
+	{0x11357, 0x11357, GB_EXTEND},
+	{0x11362, 0x11363, GB_SPACING_MARK},
+	{0x11366, 0x1136C, GB_EXTEND},
+	{0x11370, 0x11374, GB_EXTEND},
+	{0x11435, 0x11437, GB_SPACING_MARK},
+	{0x11438, 0x1143F, GB_EXTEND},
+	{0x11440, 0x11441, GB_SPACING_MARK},
+	{0x11442, 0x11444, GB_EXTEND},
+	{0x11445, 0x11445, GB_SPACING_MARK},
+	{0x11446, 0x11446, GB_EXTEND},
+	{0x1145E, 0x1145E, GB_EXTEND},
+	{0x114B0, 0x114B0, GB_EXTEND},
+	{0x114B1, 0x114B2, GB_SPACING_MARK},
+	{0x114B3, 0x114B8, GB_EXTEND},
+	{0x114B9, 0x114B9, GB_SPACING_MARK},
+	{0x114BA, 0x114BA, GB_EXTEND},
+	{0x114BB, 0x114BC, GB_SPACING_MARK},
+	{0x114BD, 0x114BD, GB_EXTEND},
+	{0x114BE, 0x114BE, GB_SPACING_MARK},
+	{0x114BF, 0x114C0, GB_EXTEND},
+	{0x114C1, 0x114C1, GB_SPACING_MARK},
+	{0x114C2, 0x114C3, GB_EXTEND},
+	{0x115AF, 0x115AF, GB_EXTEND},
+	{0x115B0, 0x115B1, GB_SPACING_MARK},
+	{0x115B2, 0x115B5, GB_EXTEND},
+	{0x115B8, 0x115BB, GB_SPACING_MARK},
+	{0x115BC, 0x115BD, GB_EXTEND},
+	{0x115BE, 0x115BE, GB_SPACING_MARK},
+	{0x115BF, 0x115C0, GB_EXTEND},
+	{0x115DC, 0x115DD, GB_EXTEND},
+	{0x11630, 0x11632, GB_SPACING_MARK},
+	{0x11633, 0x1163A, GB_EXTEND},
+	{0x1163B, 0x1163C, GB_SPACING_MARK},
+	{0x1163D, 0x1163D, GB_EXTEND},
+	{0x1163E, 0x1163E, GB_SPACING_MARK},
+	{0x1163F, 0x11640, GB_EXTEND},
+	{0x116AB, 0x116AB, GB_EXTEND},
+	{0x116AC, 0x116AC, GB_SPACING_MARK},
+	{0x116AD, 0x116AD, GB_EXTEND},
+	{0x116AE, 0x116AF, GB_SPACING_MARK},
+	{0x116B0, 0x116B5, GB_EXTEND},
+	{0x116B6, 0x116B6, GB_SPACING_MARK},
+	{0x116B7, 0x116B7, GB_EXTEND},
+	{0x1171D, 0x1171F, GB_EXTEND},
+	{0x11722, 0x11725, GB_EXTEND},
+	{0x11726, 0x11726, GB_SPACING_MARK},
+	{0x11727, 0x1172B, GB_EXTEND},
+	{0x1182C, 0x1182E, GB_SPACING_MARK},
+	{0x1182F, 0x11837, GB_EXTEND},
+	{0x11838, 0x11838, GB_SPACING_MARK},
+	{0x11839, 0x1183A, GB_EXTEND},
+	{0x11930, 0x11930, GB_EXTEND},
+	{0x11931, 0x11935, GB_SPACING_MARK},
+	{0x11937, 0x11938, GB_SPACING_MARK},
+	{0x1193B, 0x1193C, GB_EXTEND},
+	{0x1193D, 0x1193D, GB_SPACING_MARK},
+	{0x1193E, 0x1193E, GB_EXTEND},
+	{0x1193F, 0x1193F, GB_PREPEND},
+	{0x11940, 0x11940, GB_SPACING_MARK},
+	{0x11941, 0x11941, GB_PREPEND},
+	{0x11942, 0x11942, GB_SPACING_MARK},
+	{0x11943, 0x11943, GB_EXTEND},
+	{0x119D1, 0x119D3, GB_SPACING_MARK},
+	{0x119D4, 0x119D7, GB_EXTEND},
+	{0x119DA, 0x119DB, GB_EXTEND},
+	{0x119DC, 0x119DF, GB_SPACING_MARK},
+	{0x119E0, 0x119E0, GB_EXTEND},
+	{0x119E4, 0x119E4, GB_SPACING_MARK},
+	{0x11A01, 0x11A0A, GB_EXTEND},
+	{0x11A33, 0x11A38, GB_EXTEND},
+	{0x11A39, 0x11A39, GB_SPACING_MARK},
+	{0x11A3A, 0x11A3A, GB_PREPEND},
+	{0x11A3B, 0x11A3E, GB_EXTEND},
+	{0x11A47, 0x11A47, GB_EXTEND},
+	{0x11A51, 0x11A56, GB_EXTEND},
+	{0x11A57, 0x11A58, GB_SPACING_MARK},
+	{0x11A59, 0x11A5B, GB_EXTEND},
+	{0x11A84, 0x11A89, GB_PREPEND},
+	{0x11A8A, 0x11A96, GB_EXTEND},
+	{0x11A97, 0x11A97, GB_SPACING_MARK},
+	{0x11A98, 0x11A99, GB_EXTEND},
+	{0x11C2F, 0x11C2F, GB_SPACING_MARK},
+	{0x11C30, 0x11C36, GB_EXTEND},
+	{0x11C38, 0x11C3D, GB_EXTEND},
+	{0x11C3E, 0x11C3E, GB_SPACING_MARK},
+	{0x11C3F, 0x11C3F, GB_EXTEND},
+	{0x11C92, 0x11CA7, GB_EXTEND},
+	{0x11CA9, 0x11CA9, GB_SPACING_MARK},
+	{0x11CAA, 0x11CB0, GB_EXTEND},
+	{0x11CB1, 0x11CB1, GB_SPACING_MARK},
+	{0x11CB2, 0x11CB3, GB_EXTEND},
+	{0x11CB4, 0x11CB4, GB_SPACING_MARK},
+	{0x11CB5, 0x11CB6, GB_EXTEND},
+	{0x11D31, 0x11D36, GB_EXTEND},
+	{0x11D3A, 0x11D3A, GB_EXTEND},
+	{0x11D3C, 0x11D3D, GB_EXTEND},
+	{0x11D3F, 0x11D45, GB_EXTEND},
+	{0x11D46, 0x11D46, GB_PREPEND},
+	{0x11D47, 0x11D47, GB_EXTEND},
+	{0x11D8A, 0x11D8E, GB_SPACING_MARK},
+	{0x11D90, 0x11D91, GB_EXTEND},
+	{0x11D93, 0x11D94, GB_SPACING_MARK},
+	{0x11D95, 0x11D95, GB_EXTEND},
+	{0x11D96, 0x11D96, GB_SPACING_MARK},
+	{0x11D97, 0x11D97, GB_EXTEND},
+	{0x11EF3, 0x11EF4, GB_EXTEND},
+	{0x11EF5, 0x11EF6, GB_SPACING_MARK},
+	{0x13430, 0x13438, GB_CONTROL},
+	{0x16AF0, 0x16AF4, GB_EXTEND},
+	{0x16B30, 0x16B36, GB_EXTEND},
+	{0x16F4F, 0x16F4F, GB_EXTEND},
+	{0x16F51, 0x16F87, GB_SPACING_MARK},
+	{0x16F8F, 0x16F92, GB_EXTEND},
+	{0x16FE4, 0x16FE4, GB_EXTEND},
+	{0x16FF0, 0x16FF1, GB_SPACING_MARK},
+	{0x1BC9D, 0x1BC9E, GB_EXTEND},
+	{0x1BCA0, 0x1BCA3, GB_CONTROL},
+	{0x1CF00, 0x1CF2D, GB_EXTEND},
+	{0x1CF30, 0x1CF46, GB_EXTEND},
+	{0x1D165, 0x1D165, GB_EXTEND},
+	{0x1D166, 0x1D166, GB_SPACING_MARK},
+	{0x1D167, 0x1D169, GB_EXTEND},
+	{0x1D16D, 0x1D16D, GB_SPACING_MARK},
+	{0x1D16E, 0x1D172, GB_EXTEND},
+	{0x1D173, 0x1D17A, GB_CONTROL},
+	{0x1D17B, 0x1D182, GB_EXTEND},
+	{0x1D185, 0x1D18B, GB_EXTEND},
+	{0x1D1AA, 0x1D1AD, GB_EXTEND},
+	{0x1D242, 0x1D244, GB_EXTEND},
+	{0x1DA00, 0x1DA36, GB_EXTEND},
+	{0x1DA3B, 0x1DA6C, GB_EXTEND},
+	{0x1DA75, 0x1DA75, GB_EXTEND},
+	{0x1DA84, 0x1DA84, GB_EXTEND},
+	{0x1DA9B, 0x1DA9F, GB_EXTEND},
+	{0x1DAA1, 0x1DAAF, GB_EXTEND},
+	{0x1E000, 0x1E006, GB_EXTEND},
+	{0x1E008, 0x1E018, GB_EXTEND},
+	{0x1E01B, 0x1E021, GB_EXTEND},
+	{0x1E023, 0x1E024, GB_EXTEND},
+	{0x1E026, 0x1E02A, GB_EXTEND},
+	{0x1E130, 0x1E136, GB_EXTEND},
+	{0x1E2AE, 0x1E2AE, GB_EXTEND},
+	{0x1E2EC, 0x1E2EF, GB_EXTEND},
+	{0x1E8D0, 0x1E8D6, GB_EXTEND},
+	{0x1E944, 0x1E94A, GB_EXTEND},
+	{0x1F1E6, 0x1F1FF, GB_RI},
+	{0x1F3FB, 0x1F3FF, GB_EXTEND},
+	{0xE0000, 0xE001F, GB_CONTROL},
+	{0xE0020, 0xE007F, GB_EXTEND},
+	{0xE0080, 0xE00FF, GB_CONTROL},
+	{0xE0100, 0xE01EF, GB_EXTEND},
+	{0xE01F0, 0xE0FFF, GB_CONTROL},
+}
+
+// EXTENDED_PICTOGRAPHIC are the emoji and the other pictographic characters
+var EXTENDED_PICTOGRAPHIC = []classRange{
+	{0x00A9, 0x00A9, 1},
+	{0x00AE, 0x00AE, 1},
+	{0x203C, 0x203C, 1},
+	{0x2049, 0x2049, 1},
+	{0x2122, 0x2122, 1},
+	{0x2139, 0x2139, 1},
+	{0x2194, 0x2199, 1},
+	{0x21A9, 0x21AA, 1},
+	{0x231A, 0x231B, 1},
+	{0x2328, 0x2328, 1},
+	{0x2388, 0x2388, 1},
+	{0x23CF, 0x23CF, 1},
+	{0x23E9, 0x23F3, 1},
+	{0x23F8, 0x23FA, 1},
+	{0x24C2, 0x24C2, 1},
+	{0x25AA, 0x25AB, 1},
+	{0x25B6, 0x25B6, 1},
+	{0x25C0, 0x25C0, 1},
+	{0x25FB, 0x25FE, 1},
+	{0x2600, 0x2605, 1},
+	{0x2607, 0x2612, 1},
+	{0x2614, 0x2685, 1},
+	{0x2690, 0x2705, 1},
+	{0x2708, 0x2712, 1},
+	{0x2714, 0x2714, 1},
+	{0x2716, 0x2716, 1},
+	{0x271D, 0x271D, 1},
+	{0x2721, 0x2721, 1},
+	{0x2728, 0x2728, 1},
+	{0x2733, 0x2734, 1},
+	{0x2744, 0x2744, 1},
+	{0x2747, 0x2747, 1},
+	{0x274C, 0x274C, 1},
+	{0x274E, 0x274E, 1},
+	{0x2753, 0x2755, 1},
+	{0x2757, 0x2757, 1},
+	{0x2763, 0x2767, 1},
+	{0x2795, 0x2797, 1},
+	{0x27A1, 0x27A1, 1},
+	{0x27B0, 0x27B0, 1},
+	{0x27BF, 0x27BF, 1},
+	{0x2934, 0x2935, 1},
+	{0x2B05, 0x2B07, 1},
+	{0x2B1B, 0x2B1C, 1},
+	{0x2B50, 0x2B50, 1},
+	{0x2B55, 0x2B55, 1},
+	{0x3030, 0x3030, 1},
+	{0x303D, 0x303D, 1},
+	{0x3297, 0x3297, 1},
+	{0x3299, 0x3299, 1},
+	{0x1F000, 0x1F0FF, 1},
+	{0x1F10D, 0x1F10F, 1},
+	{0x1F12F, 0x1F12F, 1},
+	{0x1F16C, 0x1F171, 1},
+	{0x1F17E, 0x1F17F, 1},
+	{0x1F18E, 0x1F18E, 1},
+	{0x1F191, 0x1F19A, 1},
+	{0x1F1AD, 0x1F1E5, 1},
+	{0x1F201, 0x1F20F, 1},
+	{0x1F21A, 0x1F21A, 1},
+	{0x1F22F, 0x1F22F, 1},
+	{0x1F232, 0x1F23A, 1},
+	{0x1F23C, 0x1F23F, 1},
+	{0x1F249, 0x1F3FA, 1},
+	{0x1F400, 0x1F53D, 1},
+	{0x1F546, 0x1F64F, 1},
+	{0x1F680, 0x1F6FF, 1},
+	{0x1F774, 0x1F77F, 1},
+	{0x1F7D5, 0x1F7FF, 1},
+	{0x1F80C, 0x1F80F, 1},
+	{0x1F848, 0x1F84F, 1},
+	{0x1F85A, 0x1F85F, 1},
+	{0x1F888, 0x1F88F, 1},
+	{0x1F8AE, 0x1F8FF, 1},
+	{0x1F90C, 0x1F93A, 1},
+	{0x1F93C, 0x1F945, 1},
+	{0x1F947, 0x1FAFF, 1},
+	{0x1FC00, 0x1FFFD, 1},
+}
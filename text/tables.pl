@@ -0,0 +1,83 @@
+#!/usr/bin/perl
+# Writes tables.go from the Unicode character database of Perl, run by go generate
+use strict;
+use warnings;
+use Unicode::UCD qw(prop_invmap prop_invlist);
+
+# ranges returns the [lo, hi, value] ranges of the property without the default value
+sub ranges {
+	my ($prop, $default, $map) = @_;
+	my ($list, $values) = prop_invmap($prop);
+	my @r;
+	for my $i (0 .. $#$list) {
+		my $v = $values->[$i];
+		$v = $map->{$v} if $map && exists $map->{$v};
+		next if $v eq $default;
+		my $lo = $list->[$i];
+		my $hi = $i < $#$list ? $list->[$i + 1] - 1 : 0x10FFFF;
+		if (@r && $r[-1][2] eq $v && $r[-1][1] == $lo - 1) {
+			$r[-1][1] = $hi;
+		} else {
+			push @r, [$lo, $hi, $v];
+		}
+	}
+	return @r;
+}
+
+# set returns the [lo, hi] ranges of the binary property or of the property value
+sub set {
+	my @list = prop_invlist(shift);
+	my @r;
+	for (my $i = 0; $i < @list; $i += 2) {
+		push @r, [$list[$i], $i + 1 < @list ? $list[$i + 1] - 1 : 0x10FFFF];
+	}
+	return @r;
+}
+
+sub intersect {
+	my ($a, $b) = @_;
+	my @r;
+	for my $x (@$a) {
+		for my $y (@$b) {
+			my $lo = $x->[0] > $y->[0] ? $x->[0] : $y->[0];
+			my $hi = $x->[1] < $y->[1] ? $x->[1] : $y->[1];
+			push @r, [$lo, $hi] if $lo <= $hi;
+		}
+	}
+	return sort { $a->[0] <=> $b->[0] } @r;
+}
+
+my %gcb = (
+	'Other' => 'OTHER', 'ExtPict_XX' => 'OTHER', 'CR' => 'CR', 'LF' => 'LF', 'Control' => 'CONTROL',
+	'Extend' => 'EXTEND', 'ZWJ' => 'ZWJ', 'Regional_Indicator' => 'RI', 'Prepend' => 'PREPEND',
+	'SpacingMark' => 'SPACING_MARK', 'L' => 'L', 'V' => 'V', 'T' => 'T', 'LV' => 'LV', 'LVT' => 'LVT',
+);
+
+open(my $out, '>', 'tables.go') or die "tables.go: $!";
+select $out;
+
+printf "// Code generated by tables.pl from Unicode %s. DO NOT EDIT.\n\n", Unicode::UCD::UnicodeVersion();
+print "package text\n\n";
+
+print "// LINE_BREAK_CLASSES are the Line_Break property of the characters, XX if none\n";
+print "var LINE_BREAK_CLASSES = []classRange{\n";
+printf "\t{0x%04X, 0x%04X, LB_%s},\n", @$_ for ranges('Line_Break', 'Unknown');
+print "}\n\n";
+
+print "// WIDE_OPENINGS are the opening punctuation of the East Asian width F, W or H\n";
+print "var WIDE_OPENINGS = []classRange{\n";
+my @op = set('Line_Break=OP');
+my @wide = (set('East_Asian_Width=F'), set('East_Asian_Width=W'), set('East_Asian_Width=H'));
+printf "\t{0x%04X, 0x%04X, LB_OP},\n", @$_ for intersect(\@op, \@wide);
+print "}\n\n";
+
+print "// GRAPHEME_CLASSES are the Grapheme_Cluster_Break property of the characters, OTHER if none\n";
+print "var GRAPHEME_CLASSES = []classRange{\n";
+my @g = ranges('Grapheme_Cluster_Break', 'OTHER', \%gcb);
+printf "\t{0x%04X, 0x%04X, GB_%s},\n", @$_ for @g;
+print "}\n\n";
+
+print "// EXTENDED_PICTOGRAPHIC are the emoji and the other pictographic characters\n";
+print "var EXTENDED_PICTOGRAPHIC = []classRange{\n";
+printf "\t{0x%04X, 0x%04X, 1},\n", @$_ for set('Extended_Pictographic');
+print "}\n";